@@ -0,0 +1,60 @@
+// Package completion adds the `dap completion` subcommand, which generates
+// shell completion scripts for bash, zsh, fish, and powershell.
+package completion
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// GroupID for the completion command (matches root.go GroupShell).
+const GroupID = "shell"
+
+// Commands returns the completion-script generator.
+func Commands() []*cobra.Command {
+	return []*cobra.Command{CompletionCmd}
+}
+
+// CompletionCmd generates a shell completion script for the requested
+// shell. Its body mirrors cobra's own default completion command; it's
+// reimplemented here (rather than left as cobra's auto-added command) so it
+// gets a GroupID and renders under dap's own grouped help template.
+var CompletionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate shell completion script",
+	Long: `Generate a shell completion script for dap.
+
+To load completions:
+
+Bash:
+  $ source <(dap completion bash)
+
+Zsh:
+  $ dap completion zsh > "${fpath[1]}/_dap"
+
+Fish:
+  $ dap completion fish | source
+
+PowerShell:
+  PS> dap completion powershell | Out-String | Invoke-Expression`,
+	GroupID:               GroupID,
+	DisableFlagsInUseLine: true,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root := cmd.Root()
+		switch args[0] {
+		case "bash":
+			return root.GenBashCompletion(os.Stdout)
+		case "zsh":
+			return root.GenZshCompletion(os.Stdout)
+		case "fish":
+			return root.GenFishCompletion(os.Stdout, true)
+		case "powershell":
+			return root.GenPowerShellCompletionWithDesc(os.Stdout)
+		}
+		return fmt.Errorf("unsupported shell %q", args[0])
+	},
+}
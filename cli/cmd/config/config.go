@@ -0,0 +1,21 @@
+// Package config contains commands for inspecting and persisting dap's
+// configuration.
+package config
+
+import "github.com/spf13/cobra"
+
+// ConfigCmd is the parent command for inspecting and persisting dap's
+// configuration. Its subcommands attach themselves via their own init(),
+// the same convention cmd/k8s uses for K8sCmd.
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and persist dap's configuration",
+	Long:  "Commands for viewing dap's resolved configuration and persisting values to its config file.",
+}
+
+// Commands returns the configuration commands to be registered with the root command.
+func Commands() []*cobra.Command {
+	return []*cobra.Command{
+		ConfigCmd,
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	internalconfig "github.com/eth-library/dap/cli/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// targetFile returns the config file `init`/`set` should write to: the one
+// dap actually loaded, or DefaultConfigFile() if none was found yet.
+func targetFile() string {
+	if path := internalconfig.Default().ConfigFileUsed(); path != "" {
+		return path
+	}
+	return internalconfig.DefaultConfigFile()
+}
+
+// loadConfigMap reads path into a nested map, returning an empty map if it
+// doesn't exist yet.
+func loadConfigMap(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]interface{}{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// writeConfigMap marshals m as YAML to path, creating its parent directory
+// if needed.
+func writeConfigMap(path string, m map[string]interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// setNested sets value at the dot-separated keys path within m, creating
+// intermediate maps as needed.
+func setNested(m map[string]interface{}, keys []string, value interface{}) {
+	if len(keys) == 1 {
+		m[keys[0]] = value
+		return
+	}
+
+	next, ok := m[keys[0]].(map[string]interface{})
+	if !ok {
+		next = map[string]interface{}{}
+		m[keys[0]] = next
+	}
+	setNested(next, keys[1:], value)
+}
+
+// parseValue converts a raw --set/CLI-arg string into a bool, int, float, or
+// string, so `dap config set k8s.rollout_timeout 5m` round-trips as the same
+// type Viper would read back, while still accepting plain strings like a
+// namespace name.
+func parseValue(raw string) interface{} {
+	if b, err := strconv.ParseBool(raw); err == nil {
+		return b
+	}
+	if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f
+	}
+	return raw
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want interface{}
+	}{
+		{"true", true},
+		{"false", false},
+		{"42", int64(42)},
+		{"1.5", 1.5},
+		{"dagster", "dagster"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := parseValue(tt.raw); got != tt.want {
+				t.Errorf("parseValue(%q) = %#v, want %#v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetNestedCreatesIntermediateMaps(t *testing.T) {
+	m := map[string]interface{}{}
+	setNested(m, []string{"k8s", "namespace"}, "dagster")
+
+	k8s, ok := m["k8s"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("m[\"k8s\"] = %#v, want map[string]interface{}", m["k8s"])
+	}
+	if k8s["namespace"] != "dagster" {
+		t.Errorf("k8s.namespace = %#v, want %q", k8s["namespace"], "dagster")
+	}
+}
+
+func TestSetNestedTopLevelKey(t *testing.T) {
+	m := map[string]interface{}{}
+	setNested(m, []string{"quiet"}, true)
+
+	if m["quiet"] != true {
+		t.Errorf("m[\"quiet\"] = %#v, want true", m["quiet"])
+	}
+}
+
+func TestLoadConfigMapMissingFileReturnsEmpty(t *testing.T) {
+	m, err := loadConfigMap(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("loadConfigMap() returned error: %v", err)
+	}
+	if len(m) != 0 {
+		t.Errorf("loadConfigMap() = %#v, want empty map", m)
+	}
+}
+
+func TestWriteConfigMapThenLoadRoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dap", "config.yaml")
+
+	m := map[string]interface{}{"k8s": map[string]interface{}{"namespace": "from-test"}}
+	if err := writeConfigMap(path, m); err != nil {
+		t.Fatalf("writeConfigMap() returned error: %v", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("writeConfigMap() did not create %s: %v", path, err)
+	}
+
+	got, err := loadConfigMap(path)
+	if err != nil {
+		t.Fatalf("loadConfigMap() returned error: %v", err)
+	}
+	k8s, ok := got["k8s"].(map[string]interface{})
+	if !ok || k8s["namespace"] != "from-test" {
+		t.Errorf("round-tripped config = %#v, want k8s.namespace = from-test", got)
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+
+	internalconfig "github.com/eth-library/dap/cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// GetCmd prints the effective value of a single configuration key.
+var GetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a configuration value",
+	Long:  "Print the effective value of key, after applying flag, environment variable, and config file overrides. See 'dap config show' for the full list of keys.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println(internalconfig.Default().Get(args[0]))
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(GetCmd)
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	internalconfig "github.com/eth-library/dap/cli/internal/config"
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// initTemplate seeds a new config file with every key dap currently reads,
+// commented out so the file documents itself without silently overriding
+// the compiled-in defaults shown alongside each key.
+const initTemplate = `# dap configuration. Uncomment and edit the keys you want to override;
+# anything left commented out keeps dap's built-in default.
+#
+# Precedence: --flag > DAP_<KEY> env var > this file > built-in default.
+
+# k8s:
+#   namespace: dagster
+#   release: dagster
+#   image: da-pipeline:local
+#   context: docker-desktop
+#   rollout_timeout: 120s
+
+# dev:
+#   python_targets:
+#     - da_pipeline
+#     - da_pipeline_tests
+
+# no_color: false
+# log_format: text
+# quiet: false
+`
+
+// InitCmd creates a starter config file at the path dap would otherwise have
+// to be told about via $DAP_CONFIG, ./.dap.yaml, or $XDG_CONFIG_HOME/dap.
+var InitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create a config file with dap's defaults, commented out",
+	Long:  "Write a starter config file so its keys can be edited directly instead of exported as env vars or passed as flags every time. Refuses to overwrite an existing file.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := internalconfig.Default().ConfigFileUsed()
+		if path == "" {
+			path = internalconfig.DefaultConfigFile()
+		}
+
+		if _, err := os.Stat(path); err == nil {
+			return &errs.StatusError{
+				Message:     fmt.Sprintf("config file already exists at %s", path),
+				Code:        errs.CodeUsage,
+				Hint:        "edit it directly",
+				Suggestions: []string{"dap config set <key> <value>"},
+			}
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return &errs.StatusError{Message: "could not create config directory", Code: errs.CodeGeneric, Cause: err}
+		}
+		if err := os.WriteFile(path, []byte(initTemplate), 0o644); err != nil {
+			return &errs.StatusError{Message: "could not write config file", Code: errs.CodeGeneric, Cause: err}
+		}
+
+		ui.Success("Created config file", "path", path)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(InitCmd)
+}
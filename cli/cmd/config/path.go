@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/eth-library/dap/cli/internal/config"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// PathCmd prints the config file path dap loaded, if any.
+var PathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Show the config file path",
+	Long:  "Print the path to the config file dap loaded, or a hint where to create one if none was found.",
+	Run: func(cmd *cobra.Command, args []string) {
+		path := config.Default().ConfigFileUsed()
+		if path == "" {
+			ui.Warn("No config file found")
+			ui.Hint("dap looks for $XDG_CONFIG_HOME/dap/config.yaml")
+			return
+		}
+		fmt.Println(path)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(PathCmd)
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// SetCmd persists a single configuration value to dap's config file.
+var SetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Persist a configuration value",
+	Long:  "Write key to dap's config file so value applies to every future invocation, instead of exporting an env var or repeating a flag each time. Run 'dap config path' to see which file is used.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, value := args[0], args[1]
+		path := targetFile()
+
+		m, err := loadConfigMap(path)
+		if err != nil {
+			return &errs.StatusError{Message: "could not read config file", Code: errs.CodeGeneric, Cause: err, Hint: path}
+		}
+
+		setNested(m, strings.Split(key, "."), parseValue(value))
+
+		if err := writeConfigMap(path, m); err != nil {
+			return &errs.StatusError{Message: "could not write config file", Code: errs.CodeGeneric, Cause: err, Hint: path}
+		}
+
+		ui.Success("Saved configuration", "key", key, "value", value, "file", path)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(SetCmd)
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/eth-library/dap/cli/internal/config"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// ShowCmd prints every configuration key dap resolved, after applying flag,
+// environment variable, and config file overrides.
+var ShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the effective configuration",
+	Long:  "Display every configuration key dap resolved, after applying flag, environment variable, and config file overrides.",
+	Run: func(cmd *cobra.Command, args []string) {
+		v := config.Default()
+
+		keys := v.AllKeys()
+		sort.Strings(keys)
+
+		ui.Section("Configuration")
+		for _, key := range keys {
+			ui.KeyValue(key, fmt.Sprint(v.Get(key)))
+		}
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(ShowCmd)
+}
@@ -0,0 +1,35 @@
+package dagster
+
+import (
+	"encoding/json"
+
+	"github.com/eth-library/dap/cli/internal/complete"
+)
+
+func init() {
+	MaterializeCmd.ValidArgsFunction = complete.Func(completeAssetKeys)
+}
+
+// completeAssetKeys lists Dagster asset keys by parsing `dagster asset list
+// --json`. It returns nil (no suggestions) rather than an error if dagster
+// isn't on PATH, the command fails, or the output isn't the shape expected.
+func completeAssetKeys(toComplete string) []string {
+	out, ok := complete.ShellOutRaw("dagster", "asset", "list",
+		"-m", "da_pipeline.definitions", "--json")
+	if !ok {
+		return nil
+	}
+
+	var assets []struct {
+		Key string `json:"key"`
+	}
+	if err := json.Unmarshal([]byte(out), &assets); err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(assets))
+	for _, a := range assets {
+		keys = append(keys, a.Key)
+	}
+	return keys
+}
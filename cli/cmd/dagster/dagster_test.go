@@ -16,7 +16,7 @@ func TestCommands(t *testing.T) {
 		names[cmd.Use] = true
 	}
 
-	if !names["materialize [flags]"] {
+	if !names["materialize [asset-key] [flags]"] {
 		t.Error("missing materialize command")
 	}
 	if !names["run [flags]"] {
@@ -1,25 +1,35 @@
 package dagster
 
 import (
+	"strings"
+
 	"github.com/eth-library/dap/cli/internal/exec"
 	"github.com/spf13/cobra"
 )
 
-// MaterializeCmd materializes all Dagster assets.
+// MaterializeCmd materializes Dagster assets.
 var MaterializeCmd = &cobra.Command{
-	Use:     "materialize [flags]",
-	Short:   "Materialize Dagster assets",
-	Long:    "Materialize all Dagster assets. Any additional flags are passed to dagster.",
+	Use:   "materialize [asset-key] [flags]",
+	Short: "Materialize Dagster assets",
+	Long: `Materialize Dagster assets. Materializes everything by default; pass an
+asset key to materialize just that asset. Any additional flags are passed to
+dagster.`,
 	GroupID: GroupID,
 	// Allow passing flags through to dagster
 	DisableFlagParsing: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		selector := "*"
+		if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+			selector = args[0]
+			args = args[1:]
+		}
+
 		dagsterArgs := []string{
 			"asset", "materialize",
 			"-m", "da_pipeline.definitions",
-			"--select", "*",
+			"--select", selector,
 		}
 		dagsterArgs = append(dagsterArgs, args...)
-		return exec.RunInteractive("dagster", dagsterArgs...)
+		return exec.RunInteractiveContext(cmd.Context(), "dagster", dagsterArgs...)
 	},
 }
@@ -20,6 +20,6 @@ var RunCmd = &cobra.Command{
 			"-j", "ingest_sip_job",
 		}
 		dagsterArgs = append(dagsterArgs, args...)
-		return exec.RunInteractive("dagster", dagsterArgs...)
+		return exec.RunInteractiveContext(cmd.Context(), "dagster", dagsterArgs...)
 	},
 }
@@ -0,0 +1,237 @@
+package dev
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/report"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	checkJobs      int
+	checkFailFast  bool
+	checkReportDir string
+)
+
+// CheckCmd runs all quality checks (lint, typecheck, test).
+var CheckCmd = &cobra.Command{
+	Use:   "check [patterns...]",
+	Short: "Run all quality checks",
+	Long: `Run all quality checks: lint, typecheck, and test.
+
+  lint      ruff check + ruff format --check
+  typecheck mypy da_pipeline
+  test      pytest da_pipeline_tests
+
+With --jobs 1, the checks run one at a time and stop at the first
+failure, same as before. The default (--jobs 0, meaning NumCPU) runs
+them concurrently instead, with each check's output kept under its own
+heading and a PASS/FAIL summary printed at the end; pass --fail-fast to
+cancel the others as soon as one check fails.
+
+Accepts the same target patterns as ` + "`dap lint`" + ` to narrow lint and
+typecheck to a subset; the test step still defaults to da_pipeline_tests
+unless patterns are given. As with ` + "`dap lint`" + `, put ` + "`--`" + ` before a
+"-pattern" exclusion so it isn't parsed as a flag.
+
+Pass --report-dir to write a combined summary.json aggregating all three
+steps' outcomes (and the test step's full pass/fail/skip counts) into that
+directory, alongside a timestamped pytest-json-report report.`,
+	GroupID: GroupID,
+	Args:    cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		targets, err := ExpandTargets(args)
+		if err != nil {
+			return err
+		}
+		testTargets := []string{"da_pipeline_tests"}
+		if len(args) > 0 {
+			testTargets = targets
+		}
+
+		var testReportJSON string
+		if checkReportDir != "" {
+			if err := os.MkdirAll(checkReportDir, 0o755); err != nil {
+				return err
+			}
+			stamp := time.Now().Format("20060102-150405")
+			testReportJSON = filepath.Join(checkReportDir, "report-"+stamp+".json")
+		}
+
+		if resolveJobs() == 1 {
+			return runChecksSerially(cmd.Context(), targets, testTargets, testReportJSON)
+		}
+		return runChecksConcurrently(cmd.Context(), targets, testTargets, testReportJSON)
+	},
+}
+
+func init() {
+	CheckCmd.Flags().IntVarP(&checkJobs, "jobs", "j", 0, "How many checks to run at once (1 = one at a time, stop at first failure; default 0 = NumCPU)")
+	CheckCmd.Flags().BoolVar(&checkFailFast, "fail-fast", false, "Cancel the other checks as soon as one fails (only applies with --jobs != 1)")
+	CheckCmd.Flags().StringVar(&checkReportDir, "report-dir", "", "Write a combined summary.json (and a pytest-json-report report) to this directory")
+}
+
+// resolveJobs returns checkJobs, or runtime.NumCPU() if --jobs wasn't given
+// (0). Resolved at call time rather than baked into the flag's default so
+// the machine dap happens to build on doesn't leak into cli-manifest.json.
+func resolveJobs() int {
+	if checkJobs <= 0 {
+		return runtime.NumCPU()
+	}
+	return checkJobs
+}
+
+// testArgsWithReport appends the pytest-json-report flags needed to produce
+// reportJSON, if set.
+func testArgsWithReport(testTargets []string, reportJSON string) []string {
+	if reportJSON == "" {
+		return testTargets
+	}
+	return append(append([]string{}, testTargets...), "--json-report", "--json-report-file="+reportJSON)
+}
+
+// runChecksSerially reproduces dap check's original behavior: lint,
+// typecheck, and test run one after another, stopping at the first failure.
+// If testReportJSON is set, a combined summary.json is written alongside it
+// once the run finishes (or fails). ctx is cancelled on SIGINT/SIGTERM (see
+// cmd.Execute), which kills whichever step is currently running instead of
+// leaving it orphaned.
+func runChecksSerially(ctx context.Context, targets, testTargets []string, testReportJSON string) error {
+	ui.Section("Quality Checks")
+
+	totalSteps := 3
+	qr := report.QualityReport{}
+
+	ui.Step(1, totalSteps, "Checking code style...")
+	lintStart := time.Now()
+	lintErr := exec.RunPassthroughContext(ctx, "ruff", append([]string{"check"}, targets...)...)
+	if lintErr == nil {
+		lintErr = exec.RunPassthroughContext(ctx, "ruff", append([]string{"format", "--check"}, targets...)...)
+	}
+	qr.Lint = &report.StepOutcome{Passed: lintErr == nil, Seconds: time.Since(lintStart).Seconds()}
+	if lintErr != nil {
+		ui.StepFail(1, totalSteps, "Lint check failed")
+		writeQualityReport(testReportJSON, qr)
+		return errs.ErrLintFailed(lintErr)
+	}
+	ui.StepDone(1, totalSteps, "Lint passed")
+
+	ui.Step(2, totalSteps, "Type checking...")
+	typecheckStart := time.Now()
+	typecheckErr := exec.RunPassthroughContext(ctx, "mypy", targets...)
+	qr.Typecheck = &report.StepOutcome{Passed: typecheckErr == nil, Seconds: time.Since(typecheckStart).Seconds()}
+	if typecheckErr != nil {
+		ui.StepFail(2, totalSteps, "Type check failed")
+		writeQualityReport(testReportJSON, qr)
+		return errs.ErrTypecheckFailed(typecheckErr)
+	}
+	ui.StepDone(2, totalSteps, "Typecheck passed")
+
+	ui.Step(3, totalSteps, "Running tests...")
+	testStart := time.Now()
+	testErr := exec.RunPassthroughContext(ctx, "pytest", testArgsWithReport(testTargets, testReportJSON)...)
+	qr.Test = &report.StepOutcome{Passed: testErr == nil, Seconds: time.Since(testStart).Seconds()}
+	writeQualityReport(testReportJSON, qr)
+	if testErr != nil {
+		ui.StepFail(3, totalSteps, "Tests failed")
+		return errs.ErrTestFailed(testErr)
+	}
+	ui.StepDone(3, totalSteps, "Tests passed")
+
+	ui.Newline()
+	ui.Success("All checks passed")
+	return nil
+}
+
+// runChecksConcurrently runs lint, typecheck, and test as an exec.Group,
+// bounded by checkJobs, printing a PASS/FAIL summary once every check has
+// finished. It returns the first failing check's error (in lint, typecheck,
+// test order) so the process exit code still identifies which check failed.
+// If testReportJSON is set, a combined summary.json is written alongside it
+// once every job has finished.
+func runChecksConcurrently(ctx context.Context, targets, testTargets []string, testReportJSON string) error {
+	ui.Section("Quality Checks")
+
+	jobs := []exec.Job{
+		{Name: "lint", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			if err := exec.RunContext(ctx, stdout, stderr, "ruff", append([]string{"check"}, targets...)...); err != nil {
+				return err
+			}
+			return exec.RunContext(ctx, stdout, stderr, "ruff", append([]string{"format", "--check"}, targets...)...)
+		}},
+		{Name: "typecheck", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return exec.RunContext(ctx, stdout, stderr, "mypy", targets...)
+		}},
+		{Name: "test", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return exec.RunContext(ctx, stdout, stderr, "pytest", testArgsWithReport(testTargets, testReportJSON)...)
+		}},
+	}
+
+	results := exec.RunGroup(ctx, jobs, exec.GroupOptions{Concurrency: resolveJobs(), FailFast: checkFailFast})
+
+	ui.Newline()
+	ui.Section("Summary")
+	for _, r := range results {
+		ui.KeyValueStatus(r.Job.Name, r.Elapsed.Round(time.Millisecond).String(), r.Err == nil)
+	}
+
+	if testReportJSON != "" {
+		qr := report.QualityReport{}
+		for _, r := range results {
+			outcome := &report.StepOutcome{Passed: r.Err == nil, Seconds: r.Elapsed.Seconds()}
+			switch r.Job.Name {
+			case "lint":
+				qr.Lint = outcome
+			case "typecheck":
+				qr.Typecheck = outcome
+			case "test":
+				qr.Test = outcome
+			}
+		}
+		writeQualityReport(testReportJSON, qr)
+	}
+
+	wrap := map[string]func(error) *errs.StatusError{
+		"lint":      errs.ErrLintFailed,
+		"typecheck": errs.ErrTypecheckFailed,
+		"test":      errs.ErrTestFailed,
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			return wrap[r.Job.Name](r.Err)
+		}
+	}
+
+	ui.Success("All checks passed")
+	return nil
+}
+
+// writeQualityReport fills in qr.Test.Summary from the pytest-json-report at
+// testReportJSON (if pytest got far enough to write one) and writes the
+// combined report as summary.json next to it. Failures here are reported as
+// warnings rather than returned, since a broken report write shouldn't mask
+// the checks' own pass/fail outcome.
+func writeQualityReport(testReportJSON string, qr report.QualityReport) {
+	if testReportJSON == "" {
+		return
+	}
+
+	if qr.Test != nil {
+		if sum, err := report.ParseJSON(testReportJSON); err == nil {
+			qr.Test.Summary = &sum
+		}
+	}
+
+	if err := report.WriteSummaryJSON(filepath.Dir(testReportJSON), qr); err != nil {
+		ui.Warn(err.Error())
+	}
+}
@@ -1,14 +1,28 @@
 // Package dev contains development-related commands.
 package dev
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/eth-library/dap/cli/internal/config"
+	"github.com/spf13/cobra"
+)
 
 // GroupID for development commands (matches root.go GroupDevelopment)
 const GroupID = "development"
 
-// PythonTargets defines the Python packages to check/lint/format.
+// PythonTargets defines the Python packages to check/lint/format. It
+// defaults to da_pipeline and da_pipeline_tests but can be overridden via the
+// DAP_DEV_PYTHON_TARGETS env var (whitespace-separated) or the dev.python_targets
+// key in $XDG_CONFIG_HOME/dap/config.yaml.
 var PythonTargets = []string{"da_pipeline", "da_pipeline_tests"}
 
+func init() {
+	v := config.Default()
+	v.SetDefault("dev.python_targets", PythonTargets)
+	if targets := v.GetStringSlice("dev.python_targets"); len(targets) > 0 {
+		PythonTargets = targets
+	}
+}
+
 // Commands returns all development commands to be registered with the root command.
 func Commands() []*cobra.Command {
 	return []*cobra.Command{
@@ -55,8 +55,8 @@ func TestGroupID(t *testing.T) {
 }
 
 func TestCheckCmd(t *testing.T) {
-	if CheckCmd.Use != "check" {
-		t.Errorf("CheckCmd.Use = %q, want 'check'", CheckCmd.Use)
+	if CheckCmd.Use != "check [patterns...]" {
+		t.Errorf("CheckCmd.Use = %q, want 'check [patterns...]'", CheckCmd.Use)
 	}
 	if CheckCmd.Short == "" {
 		t.Error("CheckCmd.Short is empty")
@@ -88,8 +88,8 @@ func TestDevServerCmd(t *testing.T) {
 }
 
 func TestLintCmd(t *testing.T) {
-	if LintCmd.Use != "lint" {
-		t.Errorf("LintCmd.Use = %q, want 'lint'", LintCmd.Use)
+	if LintCmd.Use != "lint [patterns...]" {
+		t.Errorf("LintCmd.Use = %q, want 'lint [patterns...]'", LintCmd.Use)
 	}
 	if LintCmd.Short == "" {
 		t.Error("LintCmd.Short is empty")
@@ -123,9 +123,31 @@ func TestTestCmd(t *testing.T) {
 	}
 }
 
+func TestLooksLikeTargetPattern(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want bool
+	}{
+		{"...", true},
+		{"da_pipeline_tests/unit/...", true},
+		{"-da_pipeline_tests/unit/legacy", true},
+		{"-k", false},
+		{"-v", false},
+		{"--lf", false},
+		{"test_foo", false},
+		{"--ignore=da_pipeline_tests/legacy", false},
+	}
+
+	for _, tt := range tests {
+		if got := looksLikeTargetPattern(tt.arg); got != tt.want {
+			t.Errorf("looksLikeTargetPattern(%q) = %v, want %v", tt.arg, got, tt.want)
+		}
+	}
+}
+
 func TestTypecheckCmd(t *testing.T) {
-	if TypecheckCmd.Use != "typecheck" {
-		t.Errorf("TypecheckCmd.Use = %q, want 'typecheck'", TypecheckCmd.Use)
+	if TypecheckCmd.Use != "typecheck [patterns...]" {
+		t.Errorf("TypecheckCmd.Use = %q, want 'typecheck [patterns...]'", TypecheckCmd.Use)
 	}
 	if TypecheckCmd.Short == "" {
 		t.Error("TypecheckCmd.Short is empty")
@@ -1,6 +1,7 @@
 package dev
 
 import (
+	"github.com/eth-library/dap/cli/cmd/env"
 	"github.com/eth-library/dap/cli/internal/exec"
 	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
@@ -18,9 +19,13 @@ Code changes are automatically reloaded without server restart.
 Press Ctrl+C to stop the server.`,
 	GroupID: GroupID,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := env.CheckRequirements("python", "uv", "dagster"); err != nil {
+			return err
+		}
+
 		ui.TaskStart("Starting Dagster dev server...")
 		ui.KeyValue("url", "http://localhost:3000")
 		ui.Newline()
-		return exec.RunInteractive("dagster", "dev")
+		return exec.RunInteractiveContext(cmd.Context(), "dagster", "dev")
 	},
 }
@@ -0,0 +1,91 @@
+package dev
+
+import (
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintFix           bool
+	lintWatch         bool
+	lintWatchDebounce time.Duration
+)
+
+// LintCmd checks code style and formatting.
+var LintCmd = &cobra.Command{
+	Use:   "lint [patterns...]",
+	Short: "Check code style and formatting",
+	Long: `Run ruff to check code style and formatting. Use --fix to auto-fix issues.
+
+With no patterns, lints every configured root (PythonTargets). Patterns
+narrow that down: "..." means all roots, "x/..." or a bare "x" means the
+path x, and a leading "-" excludes a pattern - put "--" before a "-"
+pattern so it isn't parsed as a flag, e.g.:
+
+  dap lint da_pipeline/sources/... -- -da_pipeline/sources/legacy
+
+Pass --watch to re-run automatically whenever a watched file changes.`,
+	GroupID: GroupID,
+	Args:    cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if lintFix && lintWatch {
+			return &errs.StatusError{
+				Message: "--fix and --watch cannot be used together",
+				Code:    errs.CodeUsage,
+				Hint:    "ruff's own writes under --fix would retrigger the watcher",
+			}
+		}
+
+		targets, err := ExpandTargets(args)
+		if err != nil {
+			return err
+		}
+
+		runLint := func(string) error { return lint(cmd, targets) }
+
+		if !lintWatch {
+			return runLint("")
+		}
+		return runWatching(cmd.Context(), targets, lintWatchDebounce, runLint)
+	},
+}
+
+// lint runs ruff's check and (depending on lintFix) fix/format steps against
+// targets once.
+func lint(cmd *cobra.Command, targets []string) error {
+	if lintFix {
+		ui.Info("Fixing lint issues...")
+		if err := exec.RunPassthroughContext(cmd.Context(), "ruff", append([]string{"check", "--fix"}, targets...)...); err != nil {
+			return errs.ErrLintFailed(err)
+		}
+		ui.Info("Formatting code...")
+		if err := exec.RunPassthroughContext(cmd.Context(), "ruff", append([]string{"format"}, targets...)...); err != nil {
+			return errs.ErrLintFailed(err)
+		}
+		ui.Success("Code fixed and formatted")
+		return nil
+	}
+
+	ui.Info("Checking code style...")
+	if err := exec.RunPassthroughContext(cmd.Context(), "ruff", append([]string{"check"}, targets...)...); err != nil {
+		ui.Error("Lint check failed")
+		return errs.ErrLintFailed(err)
+	}
+	ui.Info("Checking formatting...")
+	if err := exec.RunPassthroughContext(cmd.Context(), "ruff", append([]string{"format", "--check"}, targets...)...); err != nil {
+		ui.Error("Format check failed")
+		return errs.ErrLintFailed(err)
+	}
+	ui.Success("All lint checks passed")
+	return nil
+}
+
+func init() {
+	LintCmd.Flags().BoolVar(&lintFix, "fix", false, "Auto-fix issues")
+	LintCmd.Flags().BoolVarP(&lintWatch, "watch", "w", false, "Re-run on every file change")
+	LintCmd.Flags().DurationVar(&lintWatchDebounce, "watch-debounce", defaultWatchDebounce, "How long to wait after the last change before re-running")
+}
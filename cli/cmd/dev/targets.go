@@ -0,0 +1,128 @@
+package dev
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExpandTargets resolves patterns into a deterministic, sorted list of
+// directory paths for ruff/pytest/mypy, following Go's build-tool "..."
+// convention:
+//
+//   - with no patterns, it returns a sorted copy of PythonTargets (the
+//     configured roots), preserving every command's existing default behavior.
+//   - "..." alone expands to every configured root.
+//   - "x/..." and a bare "x" both resolve to the single path x - ruff,
+//     mypy, and pytest already recurse into a directory's subtree on their
+//     own, so the "/..." suffix doesn't need to enumerate it up front. It's
+//     accepted so a reader can tell "everything under x" from "exactly x"
+//     and so an exclusion nested under x (see below) has something to
+//     splice.
+//   - a leading "-" excludes whatever the rest of the pattern matches. If
+//     the excluded path is nested under an included one, the ancestor is
+//     replaced by its immediate children other than the one leading to the
+//     exclusion, recursively, so the rest of that subtree is still covered.
+//   - any other name passes through unchanged, so ruff/pytest/mypy can
+//     report an unrecognized path themselves instead of it being silently
+//     dropped.
+func ExpandTargets(patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		out := append([]string(nil), PythonTargets...)
+		sort.Strings(out)
+		return out, nil
+	}
+
+	var includes, excludes []string
+	for _, p := range patterns {
+		if rest, ok := strings.CutPrefix(p, "-"); ok {
+			excludes = append(excludes, matchPattern(rest)...)
+			continue
+		}
+		includes = append(includes, matchPattern(p)...)
+	}
+
+	for _, exclude := range excludes {
+		var spliced []string
+		for _, include := range includes {
+			paths, err := spliceExclude(include, exclude)
+			if err != nil {
+				return nil, err
+			}
+			spliced = append(spliced, paths...)
+		}
+		includes = spliced
+	}
+
+	seen := make(map[string]bool, len(includes))
+	out := make([]string, 0, len(includes))
+	for _, t := range includes {
+		t = filepath.Clean(t)
+		if !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// matchPattern resolves a single include/exclude pattern (with any leading
+// "-" already stripped) to the path(s) it refers to.
+func matchPattern(pattern string) []string {
+	if pattern == "..." {
+		return append([]string(nil), PythonTargets...)
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return []string{prefix}
+	}
+	return []string{pattern}
+}
+
+// spliceExclude returns the paths needed to keep everything under root
+// covered except exclude. If exclude is root itself (or no longer under it),
+// it returns nothing (or root unchanged); otherwise it reads root's
+// immediate subdirectories and recurses into whichever one leads to
+// exclude, leaving its siblings untouched.
+func spliceExclude(root, exclude string) ([]string, error) {
+	root, exclude = filepath.Clean(root), filepath.Clean(exclude)
+	if root == exclude {
+		return nil, nil
+	}
+	if !isUnder(exclude, root) {
+		return []string{root}, nil
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("excluding %s from %s: %w", exclude, root, err)
+	}
+
+	var out []string
+	for _, entry := range entries {
+		child := filepath.Join(root, entry.Name())
+		if !entry.IsDir() {
+			// A loose file directly in root is unaffected by an exclusion
+			// nested in one of root's subdirectories, so it stays included.
+			out = append(out, child)
+			continue
+		}
+		if isUnder(exclude, child) {
+			spliced, err := spliceExclude(child, exclude)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, spliced...)
+			continue
+		}
+		out = append(out, child)
+	}
+	return out, nil
+}
+
+// isUnder reports whether path is ancestor itself or nested beneath it.
+func isUnder(path, ancestor string) bool {
+	return path == ancestor || strings.HasPrefix(path, ancestor+string(filepath.Separator))
+}
@@ -0,0 +1,147 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// withTargetTree creates a throwaway directory tree under t.TempDir,
+// chdirs into it for the duration of the test, and restores the previous
+// PythonTargets/working directory afterward.
+func withTargetTree(t *testing.T, dirs ...string) {
+	t.Helper()
+	root := t.TempDir()
+	for _, d := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, d), 0o755); err != nil {
+			t.Fatalf("MkdirAll(%s): %v", d, err)
+		}
+	}
+
+	prevWD, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(root); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(prevWD); err != nil {
+			t.Fatalf("restoring working directory: %v", err)
+		}
+	})
+
+	prevTargets := PythonTargets
+	PythonTargets = []string{"pkg"}
+	t.Cleanup(func() { PythonTargets = prevTargets })
+}
+
+func TestExpandTargetsNoPatterns(t *testing.T) {
+	withTargetTree(t, "pkg")
+
+	got, err := ExpandTargets(nil)
+	if err != nil {
+		t.Fatalf("ExpandTargets(nil) error = %v", err)
+	}
+	want := []string{"pkg"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets(nil) = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsEllipsisAlone(t *testing.T) {
+	withTargetTree(t, "pkg", "pkg_tests")
+	PythonTargets = []string{"pkg", "pkg_tests"}
+
+	got, err := ExpandTargets([]string{"..."})
+	if err != nil {
+		t.Fatalf("ExpandTargets([...]) error = %v", err)
+	}
+	want := []string{"pkg", "pkg_tests"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets([...]) = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsSubtreePattern(t *testing.T) {
+	withTargetTree(t, "pkg/sources/legacy")
+
+	got, err := ExpandTargets([]string{"pkg/sources/..."})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"pkg/sources"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets([pkg/sources/...]) = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsExcludesNestedDirectory(t *testing.T) {
+	withTargetTree(t, "pkg/sources/legacy", "pkg/sources/current")
+
+	got, err := ExpandTargets([]string{"pkg/sources/...", "-pkg/sources/legacy"})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"pkg/sources/current"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets with exclusion = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsExcludeWholePattern(t *testing.T) {
+	withTargetTree(t, "pkg", "pkg_tests")
+	PythonTargets = []string{"pkg", "pkg_tests"}
+
+	got, err := ExpandTargets([]string{"...", "-pkg_tests"})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"pkg"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets with whole-pattern exclusion = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsKeepsLooseFilesInSplicedDirectory(t *testing.T) {
+	withTargetTree(t, "pkg/sources/legacy", "pkg/sources/current")
+	if err := os.WriteFile(filepath.Join("pkg", "sources", "helpers.py"), nil, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := ExpandTargets([]string{"pkg/sources/...", "-pkg/sources/legacy"})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"pkg/sources/current", "pkg/sources/helpers.py"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets with exclusion = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsUnknownNamePassesThrough(t *testing.T) {
+	withTargetTree(t, "pkg")
+
+	got, err := ExpandTargets([]string{"not_a_real_package"})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"not_a_real_package"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets([not_a_real_package]) = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTargetsDeduplicatesAndSorts(t *testing.T) {
+	withTargetTree(t, "pkg")
+
+	got, err := ExpandTargets([]string{"pkg", "pkg/...", "pkg"})
+	if err != nil {
+		t.Fatalf("ExpandTargets error = %v", err)
+	}
+	want := []string{"pkg"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTargets with duplicates = %v, want %v", got, want)
+	}
+}
@@ -1,7 +1,17 @@
 package dev
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/errs"
 	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/report"
+	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -11,13 +21,44 @@ var TestCmd = &cobra.Command{
 	Short: "Run pytest tests",
 	Long: `Run the pytest test suite on da_pipeline_tests/.
 
-All arguments are passed directly to pytest.
+Arguments are passed directly to pytest, except any that look like a
+target pattern - "...", a path ending in "/...", or a "-" exclusion with a
+"/" in it - which are resolved with the same rules as ` + "`dap lint`" + `. A short
+pytest flag like -k or -v never contains a "/", so it's never mistaken for
+an exclusion.
+
+Three extra flags are handled by dap itself rather than passed through to
+pytest, since they need to inject the matching pytest-report plugin flags
+and then read the result back:
+
+  --report-junit <path>  write a JUnit XML report to path
+  --report-json <path>   write a pytest-json-report report to path
+  --report-dir <dir>     write both reports into dir, auto-named by timestamp
+
+After the run, dap parses whichever report(s) were requested and prints a
+one-line summary ("N passed, M failed, K skipped in Ts").
+
+Two more are handled the same way to support watch mode:
+
+  --watch, -w              re-run on every file change under the test
+                            targets (or pyproject.toml/ruff.toml/mypy.ini)
+  --watch-debounce <dur>   how long to wait after the last change before
+                            re-running (default 250ms)
+
+On a watch re-run, if no other pytest arguments were given (any argument
+besides target patterns and dap's own flags counts, even -v), pytest is
+also passed --lf --nf so previously-failed tests run first, followed by any
+new ones.
 
 Examples:
-  dap test                Run all tests
-  dap test -v             Verbose output
-  dap test -k "test_foo"  Run tests matching pattern
-  dap test --lf           Re-run last failed tests`,
+  dap test                                    Run all tests
+  dap test -v                                 Verbose output
+  dap test -k "test_foo"                      Run tests matching pattern
+  dap test --lf                               Re-run last failed tests
+  dap test --report-dir ./reports             Write timestamped reports to ./reports
+  dap test da_pipeline_tests/unit/...         Run only the unit test subtree
+  dap test da_pipeline_tests/unit/... \
+    -da_pipeline_tests/unit/legacy             ...excluding one subdirectory`,
 	GroupID:               GroupID,
 	DisableFlagParsing:    true,
 	DisableFlagsInUseLine: true,
@@ -28,8 +69,235 @@ Examples:
 				return cmd.Help()
 			}
 		}
-		pytestArgs := []string{"da_pipeline_tests"}
-		pytestArgs = append(pytestArgs, args...)
-		return exec.RunPassthrough("pytest", pytestArgs...)
+
+		reportFlags, rest, err := extractReportFlags(args)
+		if err != nil {
+			return err
+		}
+
+		watchOn, debounce, rest, err := extractWatchFlags(rest)
+		if err != nil {
+			return err
+		}
+
+		var patterns, pytestArgs []string
+		for _, a := range rest {
+			if looksLikeTargetPattern(a) {
+				patterns = append(patterns, a)
+				continue
+			}
+			pytestArgs = append(pytestArgs, a)
+		}
+
+		targets := []string{"da_pipeline_tests"}
+		if len(patterns) > 0 {
+			expanded, err := ExpandTargets(patterns)
+			if err != nil {
+				return err
+			}
+			targets = expanded
+		}
+
+		runPytest := func(extraArgs ...string) error {
+			finalArgs := append(append([]string{}, targets...), pytestArgs...)
+			finalArgs = append(finalArgs, reportFlags.pytestArgs()...)
+			finalArgs = append(finalArgs, extraArgs...)
+
+			runErr := exec.RunPassthroughContext(cmd.Context(), "pytest", finalArgs...)
+			if summaryErr := reportFlags.printSummary(); summaryErr != nil {
+				ui.Warn(summaryErr.Error())
+			}
+			if runErr != nil {
+				testErr := errs.ErrTestFailed(runErr)
+				var execErr *exec.StatusError
+				if errors.As(runErr, &execErr) {
+					if hint := pytestExitHint(execErr.ExitCode); hint != "" {
+						testErr.Hint = hint
+					}
+				}
+				return testErr
+			}
+			return nil
+		}
+
+		if !watchOn {
+			return runPytest()
+		}
+
+		// Prioritize failing tests on a re-run, but only when the user
+		// hasn't already narrowed the run themselves - -k, explicit node
+		// IDs, etc. all count as pytestArgs, since those bypassed the
+		// target-pattern heuristic above.
+		rerunArgs := []string{"--lf", "--nf"}
+		if len(pytestArgs) > 0 {
+			rerunArgs = nil
+		}
+		return runWatching(cmd.Context(), targets, debounce, func(path string) error {
+			if path == "" {
+				return runPytest()
+			}
+			return runPytest(rerunArgs...)
+		})
 	},
 }
+
+// pytestExitHint describes what a non-default pytest exit code means, so
+// dap test's error output can tell "tests actually failed" (pytest's own
+// code 1, the default assumption behind errs.ErrTestFailed) apart from
+// pytest not completing a run at all. errs.CodeTestFailed is kept as dap
+// test's exit code in every case - overriding it with pytest's raw exit
+// code would collide with the unrelated codes errs.go already assigns to
+// 2-5.
+func pytestExitHint(exitCode int) string {
+	switch exitCode {
+	case 2:
+		return "pytest was interrupted before finishing, not a test failure"
+	case 3:
+		return "pytest hit an internal error, not a test failure"
+	case 4:
+		return "pytest reported a usage error, not a test failure"
+	case 5:
+		return "no tests were collected, not a test failure"
+	default:
+		return ""
+	}
+}
+
+// reportPaths is the parsed form of --report-junit/--report-json/
+// --report-dir: the JUnit/JSON report paths pytest should be told to write,
+// if any.
+type reportPaths struct {
+	junit string
+	json  string
+}
+
+// pytestArgs returns the extra pytest flags needed to produce the requested
+// reports.
+func (r reportPaths) pytestArgs() []string {
+	var args []string
+	if r.junit != "" {
+		args = append(args, "--junitxml="+r.junit)
+	}
+	if r.json != "" {
+		args = append(args, "--json-report", "--json-report-file="+r.json)
+	}
+	return args
+}
+
+// printSummary parses the requested report and prints a one-line summary of
+// it. When both were requested only the JSON report is read, since pytest-json-report's duration is wall-clock for the
+// whole run while JUnit's is a per-suite sum.
+func (r reportPaths) printSummary() error {
+	switch {
+	case r.json != "":
+		sum, err := report.ParseJSON(r.json)
+		if err != nil {
+			return fmt.Errorf("reading --report-json: %w", err)
+		}
+		ui.Info(sum.String())
+	case r.junit != "":
+		sum, err := report.ParseJUnit(r.junit)
+		if err != nil {
+			return fmt.Errorf("reading --report-junit: %w", err)
+		}
+		ui.Info(sum.String())
+	}
+	return nil
+}
+
+// extractReportFlags pulls --report-junit, --report-json, and --report-dir
+// (each taking a separate value, since TestCmd's DisableFlagParsing means
+// pflag never splits "--flag value" for us) out of args, returning the
+// remaining args for pattern/pytest-arg splitting. --report-dir auto-names
+// both reports by timestamp so repeated runs don't clobber each other; it's
+// a shorthand for passing both --report-junit and --report-json explicitly.
+func extractReportFlags(args []string) (reportPaths, []string, error) {
+	var r reportPaths
+	var rest []string
+
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		var target *string
+		switch a {
+		case "--report-junit":
+			target = &r.junit
+		case "--report-json":
+			target = &r.json
+		case "--report-dir":
+			i++
+			if i >= len(args) {
+				return r, nil, fmt.Errorf("--report-dir requires a value")
+			}
+			if err := os.MkdirAll(args[i], 0o755); err != nil {
+				return r, nil, fmt.Errorf("creating --report-dir: %w", err)
+			}
+			stamp := time.Now().Format("20060102-150405")
+			if r.junit == "" {
+				r.junit = filepath.Join(args[i], "junit-"+stamp+".xml")
+			}
+			if r.json == "" {
+				r.json = filepath.Join(args[i], "report-"+stamp+".json")
+			}
+			continue
+		default:
+			rest = append(rest, a)
+			continue
+		}
+
+		i++
+		if i >= len(args) {
+			return r, nil, fmt.Errorf("%s requires a value", a)
+		}
+		*target = args[i]
+	}
+
+	return r, rest, nil
+}
+
+// extractWatchFlags pulls --watch/-w and --watch-debounce out of args the
+// same way extractReportFlags pulls out the --report-* flags, returning the
+// remaining args for pattern/pytest-arg splitting.
+func extractWatchFlags(args []string) (watch bool, debounce time.Duration, rest []string, err error) {
+	debounce = defaultWatchDebounce
+
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch a {
+		case "--watch", "-w":
+			watch = true
+		case "--watch-debounce":
+			i++
+			if i >= len(args) {
+				return false, 0, nil, fmt.Errorf("--watch-debounce requires a value")
+			}
+			d, parseErr := time.ParseDuration(args[i])
+			if parseErr != nil {
+				return false, 0, nil, fmt.Errorf("--watch-debounce: %w", parseErr)
+			}
+			debounce = d
+		default:
+			rest = append(rest, a)
+		}
+	}
+
+	return watch, debounce, rest, nil
+}
+
+// looksLikeTargetPattern reports whether a raw arg to `dap test` is a target
+// pattern rather than one of pytest's own flags/values. "..." and any "/..."
+// path are unambiguous. A "-" exclusion is only treated as a pattern if it
+// has a single leading dash and contains a "/", since pytest's own short
+// flags (-k, -v, -x, -s, ...) never do, so this never swallows one of those.
+// A "--" long flag is never treated as an exclusion even when its value
+// contains a "/", e.g. --ignore=da_pipeline_tests/legacy - that's a pytest
+// arg, not a dap target pattern.
+func looksLikeTargetPattern(a string) bool {
+	if a == "..." || strings.Contains(a, "/...") {
+		return true
+	}
+	if strings.HasPrefix(a, "--") {
+		return false
+	}
+	rest, ok := strings.CutPrefix(a, "-")
+	return ok && strings.Contains(rest, "/")
+}
@@ -1,26 +1,54 @@
 package dev
 
 import (
-	"fmt"
+	"time"
 
+	"github.com/eth-library/dap/cli/internal/errs"
 	"github.com/eth-library/dap/cli/internal/exec"
 	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
+var (
+	typecheckWatch         bool
+	typecheckWatchDebounce time.Duration
+)
+
 // TypecheckCmd runs mypy type checking.
 var TypecheckCmd = &cobra.Command{
-	Use:     "typecheck",
-	Short:   "Run type checking",
-	Long:    "Run mypy to check Python type annotations.",
+	Use:   "typecheck [patterns...]",
+	Short: "Run type checking",
+	Long: "Run mypy to check Python type annotations. Accepts the same target patterns as `dap lint` to narrow the scope.\n\n" +
+		"Pass --watch to re-run automatically whenever a watched file changes.",
 	GroupID: GroupID,
+	Args:    cobra.ArbitraryArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ui.Info("Type checking with mypy...")
-		if err := exec.RunPassthrough("mypy", PythonTargets...); err != nil {
-			ui.Error("Type check failed")
-			return fmt.Errorf("mypy type check failed: %w", err)
+		targets, err := ExpandTargets(args)
+		if err != nil {
+			return err
+		}
+
+		runTypecheck := func(string) error { return typecheck(cmd, targets) }
+
+		if !typecheckWatch {
+			return runTypecheck("")
 		}
-		ui.Success("No type errors")
-		return nil
+		return runWatching(cmd.Context(), targets, typecheckWatchDebounce, runTypecheck)
 	},
 }
+
+// typecheck runs mypy against targets once.
+func typecheck(cmd *cobra.Command, targets []string) error {
+	ui.Info("Type checking with mypy...")
+	if err := exec.RunPassthroughContext(cmd.Context(), "mypy", targets...); err != nil {
+		ui.Error("Type check failed")
+		return errs.ErrTypecheckFailed(err)
+	}
+	ui.Success("No type errors")
+	return nil
+}
+
+func init() {
+	TypecheckCmd.Flags().BoolVarP(&typecheckWatch, "watch", "w", false, "Re-run on every file change")
+	TypecheckCmd.Flags().DurationVar(&typecheckWatchDebounce, "watch-debounce", defaultWatchDebounce, "How long to wait after the last change before re-running")
+}
@@ -0,0 +1,53 @@
+package dev
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/eth-library/dap/cli/internal/watch"
+)
+
+// defaultWatchDebounce is --watch-debounce's default for LintCmd, TestCmd,
+// and TypecheckCmd. It's the same value watch.Run falls back to on its own,
+// kept as one constant so the two can't drift apart.
+const defaultWatchDebounce = watch.DefaultDebounce
+
+// watchConfigFiles are watched in addition to a command's expanded targets:
+// changing any of ruff/mypy/pytest's own config should trigger a re-run even
+// though the file itself doesn't live under da_pipeline/da_pipeline_tests.
+var watchConfigFiles = []string{"pyproject.toml", "ruff.toml", "mypy.ini"}
+
+// runWatching runs check once immediately with an empty triggering path,
+// then re-runs it - with the path that triggered the change - on every
+// debounced change under targets or watchConfigFiles, until cmd.Context()
+// is cancelled (dap's SIGINT/SIGTERM handling, see cmd.Execute). A run's own
+// failure is reported but never stops the watch, since a failing check is
+// the normal reason to be watching in the first place; only a watcher setup
+// failure (e.g. too many open files) aborts runWatching itself.
+func runWatching(ctx context.Context, targets []string, debounce time.Duration, check func(path string) error) error {
+	if err := check(""); err != nil {
+		ui.Error(err.Error())
+	}
+
+	return watch.Run(ctx, targets, watchConfigFiles, watch.Options{Debounce: debounce}, func(path string) {
+		clearTerminal()
+		ui.Section(fmt.Sprintf("Re-running (changed %s)", path))
+		if err := check(path); err != nil {
+			ui.Error(err.Error())
+		}
+	})
+}
+
+// clearTerminal clears the screen before a watch re-run's output, unless
+// doing so would just dump raw escape codes: NO_COLOR and TERM=dumb both
+// signal a terminal that can't render them, and there's no terminal to
+// clear at all when output isn't a TTY.
+func clearTerminal() {
+	if ui.NoColor() || os.Getenv("TERM") == "dumb" || !ui.IsTTY() {
+		return
+	}
+	fmt.Fprint(os.Stderr, "\x1b[H\x1b[2J")
+}
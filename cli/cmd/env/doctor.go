@@ -0,0 +1,89 @@
+package env
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+// DoctorCmd checks that every tool dap depends on meets its minimum version.
+var DoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that required tools meet their minimum versions",
+	Long: `Verify python, uv, dagster, kubectl, helm, and docker are installed and
+meet the minimum versions dap requires (see MinVersions). Exits non-zero on
+any failure, so it can gate a preCommit hook or Nix shellHook.`,
+	GroupID: GroupID,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ui.Subtitle("Doctor")
+		return CheckRequirements()
+	},
+}
+
+// CheckRequirements verifies each named tool meets its minimum version from
+// MinVersions, or every tool in MinVersions if tools is empty. It prints one
+// ui.ListItemStatus line per tool and returns errs.ErrDoctorFailed if any
+// fail, so callers like upCmd can gate on a subset of tools before doing
+// real work instead of failing partway through with a cryptic subprocess
+// error.
+func CheckRequirements(tools ...string) error {
+	if len(tools) == 0 {
+		for name := range MinVersions {
+			tools = append(tools, name)
+		}
+		sort.Strings(tools)
+	}
+
+	var failed []string
+	for _, tool := range tools {
+		min, known := MinVersions[tool]
+		if !known {
+			continue
+		}
+
+		installed := toolVersionGetters[tool]()
+		satisfied := meetsMinVersion(installed, min)
+
+		label := fmt.Sprintf("%s (not found, need >= %s)", tool, min)
+		if installed != "" {
+			label = fmt.Sprintf("%s (have %s, need >= %s)", tool, installed, min)
+		}
+		ui.ListItemStatus(label, satisfied)
+
+		if !satisfied {
+			failed = append(failed, tool)
+		}
+	}
+
+	if len(failed) > 0 {
+		ui.ErrorBox("Requirements not met",
+			fmt.Sprintf("%d tool(s) below the minimum version: %s", len(failed), strings.Join(failed, ", ")))
+		return errs.ErrDoctorFailed(fmt.Errorf("tools below minimum version: %s", strings.Join(failed, ", ")))
+	}
+
+	ui.SuccessBox("All requirements met", "Every checked tool meets dap's minimum version.")
+	return nil
+}
+
+// meetsMinVersion reports whether installed (a raw version string, with or
+// without a leading "v") is at least min. A missing installed version never
+// satisfies a requirement.
+func meetsMinVersion(installed, min string) bool {
+	if installed == "" {
+		return false
+	}
+	return semver.Compare(canonicalSemver(installed), canonicalSemver(min)) >= 0
+}
+
+// canonicalSemver adds the leading "v" golang.org/x/mod/semver requires.
+func canonicalSemver(v string) string {
+	if strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
@@ -0,0 +1,36 @@
+package env
+
+import "testing"
+
+func TestMeetsMinVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed string
+		min       string
+		want      bool
+	}{
+		{"newer satisfies", "1.29.2", "1.28.0", true},
+		{"exact match satisfies", "3.12.0", "3.12.0", true},
+		{"older fails", "1.27.4", "1.28.0", false},
+		{"leading v on installed only", "v1.29.2", "1.28.0", true},
+		{"leading v on min only", "1.29.2", "v1.28.0", true},
+		{"not found fails", "", "1.28.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := meetsMinVersion(tt.installed, tt.min); got != tt.want {
+				t.Errorf("meetsMinVersion(%q, %q) = %v, want %v", tt.installed, tt.min, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRequirementsUnknownToolIsIgnored(t *testing.T) {
+	// A tool name with no MinVersions entry is silently skipped rather than
+	// treated as a failure, so callers can pass a superset of tool names
+	// without caring which ones dap actually tracks.
+	if err := CheckRequirements("not-a-real-tool"); err != nil {
+		t.Errorf("CheckRequirements() error = %v, want nil for an unknown tool", err)
+	}
+}
@@ -0,0 +1,25 @@
+package env
+
+// MinVersions is the single source of truth for the oldest version of each
+// tool dap supports, in semver form (no leading "v"). DoctorCmd enforces
+// these; CI should check the same map rather than keeping its own copy of
+// these numbers.
+var MinVersions = map[string]string{
+	"python":  "3.12.0",
+	"uv":      "0.4.0",
+	"dagster": "1.8.0",
+	"kubectl": "1.28.0",
+	"helm":    "3.12.0",
+	"docker":  "20.10.0",
+}
+
+// toolVersionGetters maps each tool doctor checks to the function that
+// reports its installed version, reusing the same probes `versions` shows.
+var toolVersionGetters = map[string]func() string{
+	"python":  getPythonVersion,
+	"uv":      getUVVersion,
+	"dagster": getDagsterVersion,
+	"kubectl": getKubectlVersion,
+	"helm":    getHelmVersion,
+	"docker":  getDockerVersion,
+}
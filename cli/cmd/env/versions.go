@@ -5,6 +5,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/eth-library/dap/cli/internal/config"
 	"github.com/eth-library/dap/cli/internal/exec"
 	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
@@ -57,8 +58,19 @@ func showVersion(name, version string) {
 	}
 }
 
+// ToolPath returns the binary to invoke for a development tool, defaulting to
+// name itself. Override via the DAP_ENV_TOOLS_<NAME> env var or the
+// env.tools.<name> key in $XDG_CONFIG_HOME/dap/config.yaml, e.g. to point at
+// a pyenv-managed python on machines where it isn't the one on PATH.
+func ToolPath(name string) string {
+	v := config.Default()
+	key := "env.tools." + name
+	v.SetDefault(key, name)
+	return v.GetString(key)
+}
+
 func getPythonVersion() string {
-	out, err := exec.Run("python", "--version")
+	out, err := exec.Run(ToolPath("python"), "--version")
 	if err != nil {
 		return ""
 	}
@@ -66,7 +78,7 @@ func getPythonVersion() string {
 }
 
 func getUVVersion() string {
-	out, err := exec.Run("uv", "--version")
+	out, err := exec.Run(ToolPath("uv"), "--version")
 	if err != nil {
 		return ""
 	}
@@ -129,3 +141,11 @@ func getHelmVersion() string {
 	}
 	return out
 }
+
+func getDockerVersion() string {
+	out, err := exec.Run("docker", "version", "--format", "{{.Client.Version}}")
+	if err != nil {
+		return ""
+	}
+	return out
+}
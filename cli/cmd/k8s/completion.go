@@ -0,0 +1,34 @@
+package k8s
+
+import (
+	"strings"
+
+	"github.com/eth-library/dap/cli/internal/complete"
+)
+
+func init() {
+	K8sCmd.RegisterFlagCompletionFunc("namespace", complete.Func(completeNamespaces))
+	K8sCmd.RegisterFlagCompletionFunc("image", complete.Func(completeImages))
+	shellCmd.ValidArgsFunction = complete.Func(completePodNames)
+}
+
+// completeNamespaces lists Kubernetes namespaces via kubectl.
+func completeNamespaces(toComplete string) []string {
+	return complete.ShellOut("kubectl", "get", "namespaces", "-o",
+		"jsonpath={range .items[*]}{.metadata.name}{\"\\n\"}{end}")
+}
+
+// completeImages lists locally built Docker image tags.
+func completeImages(toComplete string) []string {
+	return complete.ShellOut("docker", "images", "--format", "{{.Repository}}:{{.Tag}}")
+}
+
+// completePodNames lists pod names in the configured namespace, stripping
+// the "pod/" prefix kubectl's --output=name adds.
+func completePodNames(toComplete string) []string {
+	names := complete.ShellOut("kubectl", "get", "pods", "-n", Namespace, "--output=name")
+	for i, n := range names {
+		names[i] = strings.TrimPrefix(n, "pod/")
+	}
+	return names
+}
@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/k8s/diag"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diagTail   int
+	diagRedact bool
+)
+
+var diagCmd = &cobra.Command{
+	Use:   "diag",
+	Short: "Collect a support bundle from the cluster",
+	Long: `Collect a diagnostics bundle from the current cluster and write it to a
+timestamped tarball (dap-diag-<timestamp>.tar.gz).
+
+Gathers pods, jobs, PVCs, ConfigMaps, and events; Helm release status,
+values, and manifest; and a describe + logs (current and --previous) for
+every user code, webserver, and daemon pod. Use --redact to scrub the
+dagster-postgresql secret and any DATABASE_URL/token-looking values
+before archiving, so the bundle is safe to share in a bug report.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ui.Info("Collecting diagnostics...")
+
+		files, err := diag.Collect(exec.Run, diag.Options{
+			Namespace: Namespace,
+			Release:   Release,
+			Tail:      diagTail,
+			Redact:    diagRedact,
+		})
+		if err != nil {
+			ui.Error("Failed to collect diagnostics")
+			return fmt.Errorf("collecting diagnostics: %w", err)
+		}
+
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("getting working directory: %w", err)
+		}
+
+		path, err := diag.WriteBundle(cwd, files, time.Now())
+		if err != nil {
+			ui.Error("Failed to write diagnostics bundle")
+			return fmt.Errorf("writing diagnostics bundle: %w", err)
+		}
+
+		ui.Success("Diagnostics bundle written", "path", path)
+		return nil
+	},
+}
+
+func init() {
+	diagCmd.Flags().IntVar(&diagTail, "tail", 200, "Number of log lines to collect per container")
+	diagCmd.Flags().BoolVar(&diagRedact, "redact", false, "Scrub secret-looking values before archiving")
+	K8sCmd.AddCommand(diagCmd)
+}
@@ -1,34 +1,73 @@
 package k8s
 
 import (
-	"github.com/eth-library/dap/cli/internal/exec"
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sclient "github.com/eth-library/dap/cli/internal/k8s/client"
+	k8shelm "github.com/eth-library/dap/cli/internal/k8s/helm"
+
+	"github.com/eth-library/dap/cli/internal/errs"
 	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var downCmd = &cobra.Command{
 	Use:   "down",
-	Short: "Tear down Kubernetes deployment",
-	Long:  "Remove the Dagster deployment from Kubernetes.",
+	Short: "Tear down the Helm release and its namespace",
+	Long: `Uninstall the Helm release and, unless --keep-namespace is set, delete the
+namespace along with it - which takes the PVC and PostgreSQL secret dap
+created for it with it, since Kubernetes cascades namespace deletion to
+everything inside it.
+
+Use --dry-run to preview what would be removed without making changes.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ui.Info("Tearing down Dagster deployment...")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+		keepNamespace, _ := cmd.Flags().GetBool("keep-namespace")
 
-		// Uninstall Helm release
-		exec.Run("helm", "uninstall", Release, "-n", Namespace, "--wait=false")
-		ui.Success("Helm release uninstalled")
+		if dryRun {
+			ui.Section("Would remove")
+			ui.KeyValue("helm release", Release)
+			if !keepNamespace {
+				ui.KeyValue("namespace", Namespace+" (including its PVC and secrets)")
+			}
+			return nil
+		}
+
+		if !yes && !ui.Confirm(fmt.Sprintf("Tear down release %q in namespace %q?", Release, Namespace)) {
+			ui.Info("Aborted")
+			return nil
+		}
 
-		// Clean up jobs
-		exec.Run("kubectl", "delete", "jobs", "-n", Namespace, "-l", "dagster/run-id", "--timeout=10s")
+		ui.Info("Uninstalling Helm release...")
+		if _, err := k8shelm.Uninstall(Release, Namespace, K8sContext, false, false); err != nil {
+			return &errs.StatusError{Message: "Failed to uninstall Helm release", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("Helm release uninstalled")
 
-		// Clean up pods
-		exec.Run("kubectl", "delete", "pods", "-n", Namespace, "-l", "dagster/run-id",
-			"--grace-period=0", "--force", "--timeout=10s")
+		if keepNamespace {
+			ui.Success("Teardown complete")
+			return nil
+		}
 
-		// Clean up PVC
-		exec.Run("kubectl", "delete", "pvc", "dagster-storage", "-n", Namespace, "--timeout=10s")
+		cfg, err := k8sclient.RestConfig(K8sContext)
+		if err != nil {
+			return errs.ErrK8sUnavailable(err)
+		}
+		cs, err := k8sclient.Clientset(cfg)
+		if err != nil {
+			return errs.ErrK8sUnavailable(err)
+		}
 
-		// Clean up ConfigMap
-		exec.Run("kubectl", "delete", "configmap", "test-data-xml", "-n", Namespace, "--timeout=10s")
+		ui.Info("Deleting namespace...", "name", Namespace)
+		if err := cs.CoreV1().Namespaces().Delete(context.Background(), Namespace, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return &errs.StatusError{Message: "Failed to delete namespace", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("Namespace deleted", "name", Namespace)
 
 		ui.Success("Teardown complete")
 		return nil
@@ -36,5 +75,8 @@ var downCmd = &cobra.Command{
 }
 
 func init() {
+	downCmd.Flags().Bool("dry-run", false, "Preview what would be torn down without making changes")
+	downCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	downCmd.Flags().Bool("keep-namespace", false, "Uninstall the release but leave the namespace (and its PVC/secrets) in place")
 	K8sCmd.AddCommand(downCmd)
 }
@@ -0,0 +1,34 @@
+package k8s
+
+import (
+	"fmt"
+	"time"
+
+	k8shelm "github.com/eth-library/dap/cli/internal/k8s/helm"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List Helm release revisions",
+	Long:  "Show every revision recorded for the release, oldest first, for use with `dap k8s rollback --revision`.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		revisions, err := k8shelm.History(Release, Namespace, K8sContext)
+		if err != nil {
+			return &errs.StatusError{Message: "Failed to read release history", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+
+		ui.Section("Revisions")
+		for _, rev := range revisions {
+			ui.KeyValue(fmt.Sprintf("revision %d", rev.Version), fmt.Sprintf("%s (%s)", rev.Info.Status, rev.Info.LastDeployed.Format(time.RFC1123)))
+		}
+		return nil
+	},
+}
+
+func init() {
+	K8sCmd.AddCommand(historyCmd)
+}
@@ -2,24 +2,25 @@
 package k8s
 
 import (
+	"github.com/eth-library/dap/cli/internal/config"
 	"github.com/spf13/cobra"
 )
 
-// Configuration for Kubernetes deployment
-const (
-	Namespace    = "dagster"
-	Release      = "dagster"
-	Image        = "da-pipeline:local"
-	HelmChart    = "dagster/dagster"
-	HelmVersion  = "1.10.14"
-	PGSecretName = "dagster-postgresql"
-
-	// Network configuration
-	DagsterUIURL = "http://localhost:8080"
-	K8sContext   = "docker-desktop"
-
-	// Timeouts
-	RolloutTimeout = "120s"
+// Configuration for Kubernetes deployment. These resolve in order of
+// precedence via internal/config: --namespace/--release/--image/--context
+// flags on K8sCmd, DAP_K8S_* env vars, $XDG_CONFIG_HOME/dap/config.yaml, then
+// the defaults below. They are package vars rather than consts so a fork is
+// no longer required to target a non-docker-desktop cluster or namespace.
+var (
+	Namespace      string
+	Release        string
+	Image          string
+	HelmChart      string
+	HelmVersion    string
+	PGSecretName   string
+	DagsterUIURL   string
+	K8sContext     string
+	RolloutTimeout string
 )
 
 // K8sCmd is the parent command for Kubernetes operations.
@@ -27,8 +28,52 @@ var K8sCmd = &cobra.Command{
 	Use:   "k8s",
 	Short: "Kubernetes operations",
 	Long:  "Commands for deploying and managing the pipeline on Kubernetes.",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		loadConfig()
+	},
 }
 
 func init() {
-	// Subcommands are added in their respective files
+	v := config.Default()
+
+	v.SetDefault("k8s.namespace", "dagster")
+	v.SetDefault("k8s.release", "dagster")
+	v.SetDefault("k8s.image", "da-pipeline:local")
+	v.SetDefault("k8s.helm_chart", "dagster/dagster")
+	v.SetDefault("k8s.helm_version", "1.10.14")
+	v.SetDefault("k8s.pg_secret_name", "dagster-postgresql")
+	v.SetDefault("k8s.dagster_ui_url", "http://localhost:8080")
+	v.SetDefault("k8s.context", "docker-desktop")
+	v.SetDefault("k8s.rollout_timeout", "120s")
+
+	K8sCmd.PersistentFlags().String("namespace", "", "Kubernetes namespace (default \"dagster\")")
+	K8sCmd.PersistentFlags().String("release", "", "Helm release name (default \"dagster\")")
+	K8sCmd.PersistentFlags().String("image", "", "Docker image tag to build/deploy (default \"da-pipeline:local\")")
+	K8sCmd.PersistentFlags().String("context", "", "kubectl context to use (default \"docker-desktop\")")
+
+	config.BindFlag(v, "k8s.namespace", K8sCmd.PersistentFlags().Lookup("namespace"))
+	config.BindFlag(v, "k8s.release", K8sCmd.PersistentFlags().Lookup("release"))
+	config.BindFlag(v, "k8s.image", K8sCmd.PersistentFlags().Lookup("image"))
+	config.BindFlag(v, "k8s.context", K8sCmd.PersistentFlags().Lookup("context"))
+
+	// Populate the package vars once up front so code that reads them before
+	// Execute() runs PersistentPreRun (e.g. other packages' init, tests) still
+	// sees the resolved env var / config file / default chain.
+	loadConfig()
+}
+
+// loadConfig refreshes the package vars from the shared Viper instance. It
+// runs once at init and again in PersistentPreRun once flags are parsed, so
+// an explicit --namespace/--release/--image/--context takes precedence.
+func loadConfig() {
+	v := config.Default()
+	Namespace = v.GetString("k8s.namespace")
+	Release = v.GetString("k8s.release")
+	Image = v.GetString("k8s.image")
+	HelmChart = v.GetString("k8s.helm_chart")
+	HelmVersion = v.GetString("k8s.helm_version")
+	PGSecretName = v.GetString("k8s.pg_secret_name")
+	DagsterUIURL = v.GetString("k8s.dagster_ui_url")
+	K8sContext = v.GetString("k8s.context")
+	RolloutTimeout = v.GetString("k8s.rollout_timeout")
 }
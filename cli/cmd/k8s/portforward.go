@@ -0,0 +1,37 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward",
+	Short: "Forward the Dagster webserver to localhost:8080",
+	Long:  "Wait for the Dagster webserver to become ready, then forward it to localhost:8080.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := waitForReady("app.kubernetes.io/component=dagster-webserver", defaultWaitTimeout, defaultWaitInterval); err != nil {
+			return err
+		}
+
+		svcName, err := exec.Run("kubectl", "get", "svc", "-n", Namespace,
+			"-l", "app.kubernetes.io/component=dagster-webserver",
+			"-o", "jsonpath={.items[0].metadata.name}")
+		if err != nil || svcName == "" {
+			ui.Error("No webserver service found. Is Dagster deployed?")
+			return fmt.Errorf("failed to find webserver service: %w", err)
+		}
+		svcName = strings.TrimSpace(svcName)
+
+		ui.Info("Forwarding webserver", "service", svcName, "url", DagsterUIURL)
+		return exec.RunInteractive("kubectl", "port-forward", "-n", Namespace, "svc/"+svcName, "8080:80")
+	},
+}
+
+func init() {
+	K8sCmd.AddCommand(portForwardCmd)
+}
@@ -1,20 +1,34 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/eth-library/dap/cli/internal/errs"
 	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/k8s"
+	"github.com/eth-library/dap/cli/internal/retry"
 	"github.com/eth-library/dap/cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
+// attemptTimeout bounds a single `kubectl rollout status` call so a stuck
+// attempt doesn't block the retry loop from reacting to its own budget.
+const attemptTimeout = 5 * time.Second
+
 var restartCmd = &cobra.Command{
 	Use:   "restart",
 	Short: "Rebuild and restart user code pod",
-	Long:  "Rebuild the Docker image and restart the user code deployment.",
+	Long: `Rebuild the Docker image and restart the user code deployment.
+
+Waiting for the rollout retries transient conditions (the image still
+pulling, the pod still being created, the cluster briefly unreachable)
+with capped exponential backoff instead of failing on the first blip,
+since dev clusters routinely hit these for a few seconds at a time.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Check Kubernetes connectivity
-		if err := checkK8s(); err != nil {
+		if _, err := checkK8s(); err != nil {
 			return err
 		}
 
@@ -22,24 +36,25 @@ var restartCmd = &cobra.Command{
 		ui.Info("Building Docker image...")
 		if err := exec.RunPassthrough("docker", "build", "-t", Image, "-q", "."); err != nil {
 			ui.Error("Docker build failed")
-			return fmt.Errorf("docker build failed: %w", err)
+			return errs.ErrDockerBuild(err)
 		}
 		ui.Success("Image built", "tag", Image)
 
 		// Restart deployment
 		ui.Info("Restarting user code deployment...")
 		if err := exec.RunPassthrough("kubectl", "rollout", "restart", "deployment",
-			"-n", Namespace, "-l", "app.kubernetes.io/name=dagster-user-deployments"); err != nil {
+			"-n", Namespace, "-l", userDeploymentSelector); err != nil {
 			ui.Error("Restart failed")
-			return fmt.Errorf("deployment restart failed: %w", err)
+			return &errs.StatusError{Message: "Deployment restart failed", Code: errs.CodeK8sUnavailable, Cause: err}
 		}
 
-		// Wait for rollout
-		ui.Info("Waiting for rollout...")
-		if err := exec.RunPassthrough("kubectl", "rollout", "status", "deployment",
-			"-n", Namespace, "-l", "app.kubernetes.io/name=dagster-user-deployments", "--timeout="+RolloutTimeout); err != nil {
-			ui.Error("Rollout failed")
-			return fmt.Errorf("rollout status check failed: %w", err)
+		// Wait for rollout, retrying transient failures
+		policy, err := retryPolicy(cmd)
+		if err != nil {
+			return err
+		}
+		if err := waitForRolloutWithRetry(userDeploymentSelector, policy); err != nil {
+			return errs.ErrRolloutTimeout(err)
 		}
 
 		ui.Success("Restart complete")
@@ -47,6 +62,54 @@ var restartCmd = &cobra.Command{
 	},
 }
 
+// retryPolicy builds a retry.Policy from --retry-timeout/--retry-max-interval,
+// falling back to the configured k8s.rollout_timeout when --retry-timeout is
+// unset.
+func retryPolicy(cmd *cobra.Command) (retry.Policy, error) {
+	timeout, _ := cmd.Flags().GetDuration("retry-timeout")
+	if timeout <= 0 {
+		parsed, err := time.ParseDuration(RolloutTimeout)
+		if err != nil {
+			return retry.Policy{}, &errs.StatusError{
+				Message: fmt.Sprintf("invalid rollout timeout %q", RolloutTimeout),
+				Code:    errs.CodeUsage,
+				Cause:   err,
+				Hint:    "set k8s.rollout_timeout to a Go duration, e.g. 120s",
+			}
+		}
+		timeout = parsed
+	}
+
+	maxInterval, _ := cmd.Flags().GetDuration("retry-max-interval")
+
+	return retry.Policy{Timeout: timeout, MaxInterval: maxInterval}, nil
+}
+
+// waitForRolloutWithRetry polls `kubectl rollout status` for selector,
+// classifying failures as transient or terminal and reporting each attempt
+// via ui.Step/ui.StepDone/ui.StepFail.
+func waitForRolloutWithRetry(selector string, policy retry.Policy) error {
+	attempt := 0
+
+	return retry.Do(context.Background(), policy, func(ctx context.Context) error {
+		attempt++
+		ui.Step(attempt, attempt, "Waiting for rollout...")
+
+		_, err := exec.Run("kubectl", "rollout", "status", "deployment",
+			"-n", Namespace, "-l", selector, fmt.Sprintf("--timeout=%s", attemptTimeout))
+		if err == nil {
+			ui.StepDone(attempt, attempt, "Rollout ready")
+			return nil
+		}
+
+		classified := k8s.ClassifyRolloutFailure(exec.Run, Namespace, selector, err)
+		ui.StepFail(attempt, attempt, classified.Error())
+		return classified
+	})
+}
+
 func init() {
+	restartCmd.Flags().Duration("retry-timeout", 0, "Maximum time to retry a stuck rollout (default: k8s.rollout_timeout)")
+	restartCmd.Flags().Duration("retry-max-interval", 0, "Maximum delay between rollout retry attempts (default 30s)")
 	K8sCmd.AddCommand(restartCmd)
 }
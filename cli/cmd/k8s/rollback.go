@@ -0,0 +1,32 @@
+package k8s
+
+import (
+	k8shelm "github.com/eth-library/dap/cli/internal/k8s/helm"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the Helm release to a previous revision",
+	Long:  "Revert the release to --revision, or to the previous revision if --revision is unset. See `dap k8s history` for available revisions.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		revision, _ := cmd.Flags().GetInt("revision")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		ui.Info("Rolling back release...", "release", Release, "revision", revision)
+		if err := k8shelm.Rollback(Release, Namespace, K8sContext, revision, dryRun); err != nil {
+			return &errs.StatusError{Message: "Rollback failed", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("Rollback complete")
+		return nil
+	},
+}
+
+func init() {
+	rollbackCmd.Flags().Int("revision", 0, "Revision to roll back to (default: the previous revision)")
+	rollbackCmd.Flags().Bool("dry-run", false, "Simulate the rollback without making changes")
+	K8sCmd.AddCommand(rollbackCmd)
+}
@@ -10,20 +10,31 @@ import (
 )
 
 var shellCmd = &cobra.Command{
-	Use:   "shell",
+	Use:   "shell [pod]",
 	Short: "Open shell in user code pod",
-	Long:  "Open an interactive bash shell in the Dagster user code pod.",
+	Long:  "Open an interactive bash shell in the Dagster user code pod. If pod is omitted, the first matching user code pod is used.",
+	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// Get pod name
-		podName, err := exec.Run("kubectl", "get", "pods", "-n", Namespace,
-			"-l", "app.kubernetes.io/name=dagster-user-deployments",
-			"-o", "jsonpath={.items[0].metadata.name}")
-		if err != nil || podName == "" {
-			ui.Error("No user code pod found. Is Dagster deployed?")
-			return fmt.Errorf("failed to find user code pod: %w", err)
+		if err := waitForReady(userDeploymentSelector, defaultWaitTimeout, defaultWaitInterval); err != nil {
+			return err
+		}
+
+		podName := ""
+		if len(args) == 1 {
+			podName = args[0]
+		}
+
+		if podName == "" {
+			out, err := exec.Run("kubectl", "get", "pods", "-n", Namespace,
+				"-l", "app.kubernetes.io/name=dagster-user-deployments",
+				"-o", "jsonpath={.items[0].metadata.name}")
+			if err != nil || out == "" {
+				ui.Error("No user code pod found. Is Dagster deployed?")
+				return fmt.Errorf("failed to find user code pod: %w", err)
+			}
+			podName = strings.TrimSpace(out)
 		}
 
-		podName = strings.TrimSpace(podName)
 		ui.Info("Connecting to pod", "name", podName)
 
 		return exec.RunInteractive("kubectl", "exec", "-it", "-n", Namespace, podName, "--", "/bin/bash")
@@ -0,0 +1,60 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"helm.sh/helm/v3/pkg/storage/driver"
+
+	k8shelm "github.com/eth-library/dap/cli/internal/k8s/helm"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the Helm release status",
+	Long:  "Display the deployed release's status, chart version, and value overrides, backed by the Helm SDK instead of `helm status`.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rel, err := k8shelm.ReleaseStatus(Release, Namespace, K8sContext)
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			ui.Warn("Deployment not running")
+			ui.CommandHint("dap k8s up", "deploy to Kubernetes")
+			return nil
+		}
+		if err != nil {
+			return &errs.StatusError{Message: "Failed to read release status", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+
+		ui.Section("Release")
+		ui.KeyValue("name", rel.Name)
+		ui.KeyValue("status", rel.Info.Status.String())
+		ui.KeyValue("revision", fmt.Sprintf("%d", rel.Version))
+		ui.KeyValue("last deployed", rel.Info.LastDeployed.Format(time.RFC1123))
+		if rel.Chart != nil && rel.Chart.Metadata != nil {
+			ui.KeyValue("chart", fmt.Sprintf("%s-%s", rel.Chart.Metadata.Name, rel.Chart.Metadata.Version))
+		}
+
+		if len(rel.Config) > 0 {
+			ui.Section("Values (overrides from chart defaults)")
+			keys := make([]string, 0, len(rel.Config))
+			for k := range rel.Config {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				ui.KeyValue(k, fmt.Sprintf("%v", rel.Config[k]))
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	K8sCmd.AddCommand(statusCmd)
+}
@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"os"
+
+	"github.com/eth-library/dap/cli/cmd/env"
+	k8sclient "github.com/eth-library/dap/cli/internal/k8s/client"
+	k8shelm "github.com/eth-library/dap/cli/internal/k8s/helm"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+)
+
+const testDataDir = "da_pipeline_tests/test_data"
+
+var upCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Build and deploy to local Kubernetes",
+	Long:  "Build the Docker image and deploy to local Kubernetes cluster (localhost:8080).",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := env.CheckRequirements("docker"); err != nil {
+			return err
+		}
+
+		cfg, err := checkK8s()
+		if err != nil {
+			return err
+		}
+
+		ui.Info("Building Docker image...")
+		if err := exec.RunPassthrough("docker", "build", "-t", Image, "-q", "."); err != nil {
+			ui.Error("Docker build failed")
+			return errs.ErrDockerBuild(err)
+		}
+		ui.Success("Image built", "tag", Image)
+
+		cs, err := k8sclient.Clientset(cfg)
+		if err != nil {
+			return errs.ErrK8sUnavailable(err)
+		}
+		ctx := context.Background()
+
+		ui.Info("Creating namespace...")
+		if err := k8sclient.EnsureNamespace(ctx, cs, Namespace); err != nil {
+			return &errs.StatusError{Message: "Failed to create namespace", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("Namespace ready", "name", Namespace)
+
+		created, err := k8sclient.EnsureSecret(ctx, cs, Namespace, PGSecretName, "postgresql-password")
+		if err != nil {
+			return &errs.StatusError{Message: "Failed to create PostgreSQL secret", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		if created {
+			ui.Success("PostgreSQL secret created")
+		}
+
+		ui.Info("Applying persistent volume claim...")
+		if err := k8sclient.ApplyPVC(ctx, cs, Namespace, "helm/pvc.yaml"); err != nil {
+			return &errs.StatusError{Message: "Failed to apply PVC", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("PVC applied")
+
+		if _, err := os.Stat(testDataDir); err == nil {
+			ui.Info("Creating test data ConfigMap...")
+			if err := k8sclient.ApplyConfigMapFromDir(ctx, cs, Namespace, "test-data-xml", testDataDir); err != nil {
+				return &errs.StatusError{Message: "Failed to create test data ConfigMap", Code: errs.CodeK8sUnavailable, Cause: err}
+			}
+			ui.Success("Test data ConfigMap created")
+		}
+
+		ui.Info("Deploying with Helm...", "version", HelmVersion)
+		if _, err := k8shelm.UpgradeInstall(k8shelm.Config{
+			ReleaseName: Release,
+			Namespace:   Namespace,
+			Chart:       HelmChart,
+			Version:     HelmVersion,
+			KubeContext: K8sContext,
+			ValueFiles:  []string{"helm/values.yaml", "helm/values-local.yaml"},
+		}); err != nil {
+			ui.Error("Helm deployment failed")
+			return &errs.StatusError{Message: "Helm deployment failed", Code: errs.CodeK8sUnavailable, Cause: err}
+		}
+		ui.Success("Dagster deployed")
+
+		if noWait, _ := cmd.Flags().GetBool("no-wait"); !noWait {
+			if err := waitForReadyWithClient(cs, userDeploymentSelector, defaultWaitTimeout, defaultWaitInterval); err != nil {
+				return err
+			}
+		}
+
+		ui.Info("UI available at", "url", DagsterUIURL)
+		ui.Hint("Service exposed via LoadBalancer - no port-forward needed")
+		return nil
+	},
+}
+
+// checkK8s verifies the configured Kubernetes context is reachable and
+// returns its rest.Config for reuse by the rest of the command, replacing
+// the old `kubectl cluster-info` + `kubectl config use-context` pair with a
+// single discovery call against the context dap is actually about to use.
+func checkK8s() (*rest.Config, error) {
+	cfg, err := k8sclient.RestConfig(K8sContext)
+	if err != nil {
+		ui.Error("Kubernetes not available. Enable it in Docker Desktop.")
+		return nil, errs.ErrK8sUnavailable(err)
+	}
+
+	version, err := k8sclient.ServerVersion(cfg)
+	if err != nil {
+		ui.Error("Kubernetes not available. Enable it in Docker Desktop.")
+		return nil, errs.ErrK8sUnavailable(err)
+	}
+
+	ui.Success("Kubernetes cluster connected", "version", version, "context", K8sContext)
+	return cfg, nil
+}
+
+func init() {
+	upCmd.Flags().Bool("no-wait", false, "Exit immediately after deploying instead of waiting for pods to become ready")
+	K8sCmd.AddCommand(upCmd)
+}
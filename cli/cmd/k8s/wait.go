@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	k8sclient "github.com/eth-library/dap/cli/internal/k8s/client"
+	"github.com/eth-library/dap/cli/internal/k8s/wait"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultWaitTimeout     = 5 * time.Minute
+	defaultWaitInterval    = 2 * time.Second
+	userDeploymentSelector = "app.kubernetes.io/name=dagster-user-deployments"
+)
+
+var waitCmd = &cobra.Command{
+	Use:   "wait",
+	Short: "Wait for the user code deployment to become ready",
+	Long:  "Poll the Dagster user code pods until they report ready.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		return waitForReady(userDeploymentSelector, timeout, interval)
+	},
+}
+
+func init() {
+	waitCmd.Flags().Duration("timeout", defaultWaitTimeout, "Maximum time to wait for pods to become ready")
+	waitCmd.Flags().Duration("interval", defaultWaitInterval, "Delay between readiness polls")
+	K8sCmd.AddCommand(waitCmd)
+}
+
+// waitForReady builds its own client from the configured context and blocks
+// until every pod matching selector in Namespace reports PodReady.
+func waitForReady(selector string, timeout, interval time.Duration) error {
+	cfg, err := k8sclient.RestConfig(K8sContext)
+	if err != nil {
+		return errs.ErrK8sUnavailable(err)
+	}
+	cs, err := k8sclient.Clientset(cfg)
+	if err != nil {
+		return errs.ErrK8sUnavailable(err)
+	}
+
+	return waitForReadyWithClient(cs, selector, timeout, interval)
+}
+
+// waitForReadyWithClient blocks until every pod matching selector in
+// Namespace reports PodReady, printing one ui.Step line per pod on each poll
+// so progress (and, on a stuck pod, its waiting reason) is visible as it
+// happens. It takes an already-built client so upCmd can reuse the one it
+// built to deploy instead of authenticating twice.
+func waitForReadyWithClient(cs kubernetes.Interface, selector string, timeout, interval time.Duration) error {
+	ui.Info("Waiting for pods to become ready...", "selector", selector)
+
+	err := wait.Wait(context.Background(), cs, wait.Options{
+		Namespace:   Namespace,
+		PodSelector: selector,
+		Timeout:     timeout,
+		Interval:    interval,
+		OnEvent:     reportPodProgress,
+	})
+	if err != nil {
+		return errs.ErrRolloutTimeout(err)
+	}
+
+	ui.Success("Pods ready")
+	return nil
+}
+
+// reportPodProgress renders one event's pod statuses through ui.Step so each
+// pod gets its own progress line: ui.StepDone once it's ready, ui.Step with
+// its waiting reason (or phase) otherwise.
+func reportPodProgress(e wait.Event) {
+	total := len(e.Pods)
+	for i, pod := range e.Pods {
+		if pod.Ready {
+			ui.StepDone(i+1, total, pod.Name)
+			continue
+		}
+		reason := pod.WaitingReason
+		if reason == "" {
+			reason = pod.Phase
+		}
+		ui.Step(i+1, total, pod.Name+": "+reason)
+	}
+}
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,10 +25,10 @@ var CliBuildCmd = &cobra.Command{
 	Short: "Rebuild the dap CLI",
 	Long:  "Runs go mod tidy, gomod2nix, and nix build to rebuild the CLI.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := inCliDir(func() error {
+		if err := inCliDir(cmd.Context(), func() error {
 			// Step 1: go mod tidy
 			ui.Info("Running go mod tidy...")
-			if err := exec.RunPassthrough("go", "mod", "tidy"); err != nil {
+			if err := exec.RunPassthroughContext(cmd.Context(), "go", "mod", "tidy"); err != nil {
 				ui.Error("go mod tidy failed", "error", err)
 				return err
 			}
@@ -38,7 +39,7 @@ var CliBuildCmd = &cobra.Command{
 				ui.Warn("gomod2nix not found in PATH - skipping")
 			} else {
 				ui.Info("Running gomod2nix...")
-				if err := exec.RunPassthrough("gomod2nix"); err != nil {
+				if err := exec.RunPassthroughContext(cmd.Context(), "gomod2nix"); err != nil {
 					ui.Error("gomod2nix failed", "error", err)
 					return err
 				}
@@ -51,7 +52,7 @@ var CliBuildCmd = &cobra.Command{
 		}
 
 		// Step 3: nix build (must run from repo root where flake.nix lives)
-		root, err := repoRoot()
+		root, err := repoRoot(cmd.Context())
 		if err != nil {
 			return err
 		}
@@ -59,7 +60,7 @@ var CliBuildCmd = &cobra.Command{
 			return err
 		}
 		ui.Info("Running nix build...")
-		if err := exec.RunPassthrough("nix", "build", ".#dap"); err != nil {
+		if err := exec.RunPassthroughContext(cmd.Context(), "nix", "build", ".#dap"); err != nil {
 			ui.Error("nix build failed", "error", err)
 			return err
 		}
@@ -79,13 +80,13 @@ var CliTestCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		verbose, _ := cmd.Flags().GetBool("verbose")
 
-		return inCliDir(func() error {
+		return inCliDir(cmd.Context(), func() error {
 			ui.Info("Running CLI tests...")
 			testArgs := []string{"test", "./..."}
 			if verbose {
 				testArgs = append(testArgs, "-v")
 			}
-			if err := exec.RunPassthrough("go", testArgs...); err != nil {
+			if err := exec.RunPassthroughContext(cmd.Context(), "go", testArgs...); err != nil {
 				ui.Error("Tests failed", "error", err)
 				return err
 			}
@@ -103,30 +104,30 @@ var CliLintCmd = &cobra.Command{
 	Short: "Lint and check formatting",
 	Long:  "Runs go vet and checks gofmt formatting for all CLI packages. Use --fix to auto-format.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return inCliDir(func() error {
+		return inCliDir(cmd.Context(), func() error {
 			if cliLintFix {
-				return lintFixCli()
+				return lintFixCli(cmd.Context())
 			}
-			return lintCheckCli()
+			return lintCheckCli(cmd.Context())
 		})
 	},
 }
 
 // lintFixCli formats code and runs go vet.
-func lintFixCli() error {
+func lintFixCli(ctx context.Context) error {
 	ui.Info("Fixing formatting...")
-	if err := exec.RunPassthrough("gofmt", "-w", "."); err != nil {
+	if err := exec.RunPassthroughContext(ctx, "gofmt", "-w", "."); err != nil {
 		ui.Error("gofmt -w failed", "error", err)
 		return err
 	}
 	ui.Success("Formatting fixed")
 
-	return goVet()
+	return goVet(ctx)
 }
 
 // lintCheckCli runs go vet and checks formatting without modifying files.
-func lintCheckCli() error {
-	if err := goVet(); err != nil {
+func lintCheckCli(ctx context.Context) error {
+	if err := goVet(ctx); err != nil {
 		return err
 	}
 
@@ -149,9 +150,9 @@ func lintCheckCli() error {
 }
 
 // goVet runs go vet on all packages.
-func goVet() error {
+func goVet(ctx context.Context) error {
 	ui.Info("Running go vet...")
-	if err := exec.RunPassthrough("go", "vet", "./..."); err != nil {
+	if err := exec.RunPassthroughContext(ctx, "go", "vet", "./..."); err != nil {
 		ui.Error("go vet failed", "error", err)
 		return err
 	}
@@ -160,8 +161,8 @@ func goVet() error {
 }
 
 // inCliDir runs fn inside the cli directory, restoring the original directory afterwards.
-func inCliDir(fn func() error) error {
-	cliDir, err := findCliDir()
+func inCliDir(ctx context.Context, fn func() error) error {
+	cliDir, err := findCliDir(ctx)
 	if err != nil {
 		return err
 	}
@@ -173,18 +174,23 @@ func inCliDir(fn func() error) error {
 	return fn()
 }
 
-// repoRoot returns the absolute path to the git repository root.
-func repoRoot() (string, error) {
-	root, err := exec.Run("git", "rev-parse", "--show-toplevel")
+// repoRoot returns the absolute path to the git repository root. It uses
+// RunCaptured rather than Run so a failure (e.g. running dap outside any git
+// repo) can include git's own stderr instead of a bare "exit status 128".
+func repoRoot(ctx context.Context) (string, error) {
+	stdout, stderr, err := exec.RunCaptured(ctx, "git", "rev-parse", "--show-toplevel")
 	if err != nil {
+		if stderr != "" {
+			return "", fmt.Errorf("not in a git repository: %s: %w", stderr, err)
+		}
 		return "", fmt.Errorf("not in a git repository: %w", err)
 	}
-	return strings.TrimSpace(root), nil
+	return strings.TrimSpace(stdout), nil
 }
 
 // findCliDir returns the absolute path to the cli directory using the git repo root.
-func findCliDir() (string, error) {
-	root, err := repoRoot()
+func findCliDir(ctx context.Context) (string, error) {
+	root, err := repoRoot(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,102 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/manifest"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// MetaCmd is the hidden parent for commands that describe or maintain the
+// CLI itself, as opposed to CliCmd's build/test/lint commands for the CLI's
+// own Go module.
+var MetaCmd = &cobra.Command{
+	Use:    "meta",
+	Short:  "CLI introspection commands",
+	Long:   "Commands that describe dap's own command tree, for CI and tooling.",
+	Hidden: true,
+}
+
+var (
+	manifestCheckFile string
+	manifestOutput    string
+	manifestSchema    bool
+)
+
+// ManifestCmd walks the fully-built root command tree and prints a JSON
+// description of every command, flag, and group. It's meant to be invoked
+// both interactively and from `go generate` (see the directive in main.go),
+// so the committed cli-manifest.json stays in sync with the actual tree.
+var ManifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Print or check the CLI's command manifest",
+	Long: `Walks the root command tree and emits a JSON description of every
+command, flag, and group, suitable for committing as cli-manifest.json and
+diffing in CI to catch an accidental flag removal or rename.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if manifestSchema {
+			return printJSON(os.Stdout, manifest.Schema())
+		}
+
+		m := manifest.Build(cmd.Root())
+
+		if manifestCheckFile != "" {
+			return checkManifest(m, manifestCheckFile)
+		}
+
+		if manifestOutput != "" {
+			f, err := os.Create(manifestOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := printJSON(f, m); err != nil {
+				return err
+			}
+			ui.Success(fmt.Sprintf("Wrote manifest to %s", manifestOutput))
+			return nil
+		}
+
+		return printJSON(os.Stdout, m)
+	},
+}
+
+// checkManifest compares the freshly-built manifest m against the one
+// committed at path, failing with errs.StatusError if they diverge.
+func checkManifest(m manifest.Manifest, path string) error {
+	want, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		return errs.ErrManifestDrift(fmt.Errorf("reading %s: %w", path, err))
+	}
+
+	if !bytes.Equal(bytes.TrimSpace(got), want) {
+		return errs.ErrManifestDrift(fmt.Errorf("%s is out of date with the current command tree", path))
+	}
+
+	ui.Success(fmt.Sprintf("%s matches the current command tree", path))
+	return nil
+}
+
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func init() {
+	ManifestCmd.Flags().StringVar(&manifestCheckFile, "check", "", "Compare against a committed manifest file instead of printing")
+	ManifestCmd.Flags().StringVarP(&manifestOutput, "output", "o", "", "Write the manifest to a file instead of stdout")
+	ManifestCmd.Flags().BoolVar(&manifestSchema, "schema", false, "Print the manifest's JSON Schema instead of the manifest itself")
+	MetaCmd.AddCommand(ManifestCmd)
+}
@@ -5,5 +5,5 @@ import "github.com/spf13/cobra"
 
 // Commands returns hidden CLI maintenance commands.
 func Commands() []*cobra.Command {
-	return []*cobra.Command{}
+	return []*cobra.Command{MetaCmd}
 }
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -8,8 +9,14 @@ import (
 
 func TestCommands(t *testing.T) {
 	cmds := Commands()
-	if len(cmds) != 0 {
-		t.Errorf("Commands() returned %d commands, want 0", len(cmds))
+	if len(cmds) != 1 {
+		t.Fatalf("Commands() returned %d commands, want 1", len(cmds))
+	}
+	if cmds[0] != MetaCmd {
+		t.Errorf("Commands()[0] = %v, want MetaCmd", cmds[0])
+	}
+	if !MetaCmd.Hidden {
+		t.Error("MetaCmd should be hidden")
 	}
 }
 
@@ -36,7 +43,7 @@ func TestFindCliDir(t *testing.T) {
 	// Test from within cli directory (where go.mod and gomod2nix.toml exist)
 	if _, err := os.Stat("go.mod"); err == nil {
 		if _, err := os.Stat("gomod2nix.toml"); err == nil {
-			dir, err := findCliDir()
+			dir, err := findCliDir(context.Background())
 			if err != nil {
 				t.Errorf("findCliDir() from cli failed: %v", err)
 			}
@@ -66,7 +73,7 @@ func TestFindCliDirFromParent(t *testing.T) {
 		t.Skip("not in expected directory structure (Nix build environment)")
 	}
 
-	dir, err := findCliDir()
+	dir, err := findCliDir(context.Background())
 	if err != nil {
 		t.Errorf("findCliDir() from parent failed: %v", err)
 		return
@@ -0,0 +1,217 @@
+// Package cmd contains all CLI commands for dap.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/eth-library/dap/cli/cmd/completion"
+	"github.com/eth-library/dap/cli/cmd/config"
+	"github.com/eth-library/dap/cli/cmd/dagster"
+	"github.com/eth-library/dap/cli/cmd/dev"
+	"github.com/eth-library/dap/cli/cmd/env"
+	"github.com/eth-library/dap/cli/cmd/k8s"
+	"github.com/eth-library/dap/cli/cmd/meta"
+	clitemplate "github.com/eth-library/dap/cli/internal/cli"
+	internalconfig "github.com/eth-library/dap/cli/internal/config"
+	"github.com/eth-library/dap/cli/internal/errs"
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// Command group IDs for organized --help output
+const (
+	GroupDevelopment   = "development"
+	GroupEnvironment   = "environment"
+	GroupDagster       = "dagster"
+	GroupKubernetes    = "kubernetes"
+	GroupGoCLI         = "gocli"
+	GroupConfiguration = "configuration"
+	GroupShell         = "shell"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "dap",
+	Short: "Developer tools for the Data Archive Pipeline (DAP) Orchestrator",
+	Long: `dap is the CLI for the Data Archive Pipeline (DAP) Orchestrator.
+
+A Dagster-based orchestrator for processing digital assets following
+the OAIS reference model. This tool provides commands for local development,
+testing, code quality, and Kubernetes deployment.`,
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		v := internalconfig.Default()
+
+		if v.GetBool("no_color") {
+			ui.DisableColors()
+		}
+		ui.SetQuiet(v.GetBool("quiet"))
+
+		if err := ui.SetFormat(v.GetString("log_format")); err != nil {
+			return &errs.StatusError{
+				Message: err.Error(),
+				Code:    errs.CodeUsage,
+				Cause:   err,
+				Hint:    fmt.Sprintf("see '%s --help'", cmd.CommandPath()),
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	SetupRootCommand(rootCmd)
+}
+
+// SetupRootCommand installs dap's command groups, global flags, help
+// template, and error handling on cmd. It is split out from init() so other
+// entry points (tests, a future manifest generator) can build an equivalent
+// command tree.
+func SetupRootCommand(cmd *cobra.Command) {
+	// Without this, cobra only runs the nearest PersistentPreRun(E) in the
+	// command chain, so K8sCmd's own PersistentPreRun (loading its package
+	// vars) would shadow this root hook for the whole k8s subtree, silently
+	// skipping --no-color/--quiet/--log-format there. Traversing runs every
+	// ancestor's hook, root to leaf.
+	cobra.EnableTraverseRunHooks = true
+
+	// Disable default completion command
+	cmd.CompletionOptions.DisableDefaultCmd = true
+
+	// Define command groups for organized help output
+	cmd.AddGroup(
+		&cobra.Group{ID: GroupDevelopment, Title: "Development:"},
+		&cobra.Group{ID: GroupEnvironment, Title: "Environment:"},
+		&cobra.Group{ID: GroupDagster, Title: "Dagster:"},
+		&cobra.Group{ID: GroupKubernetes, Title: "Kubernetes:"},
+		&cobra.Group{ID: GroupGoCLI, Title: "CLI Development:"},
+		&cobra.Group{ID: GroupConfiguration, Title: "Configuration:"},
+		&cobra.Group{ID: GroupShell, Title: "Shell:"},
+	)
+
+	// Global flags. Each is bound to a config key so it can also be set via
+	// DAP_<KEY> env var or persisted with `dap config set <key> <value>`
+	// instead of passed on every invocation.
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output")
+	cmd.PersistentFlags().String("log-format", "", fmt.Sprintf("Output format: %s, %s, or %s (default: %s, or %s in GitHub Actions)",
+		ui.FormatText, ui.FormatJSON, ui.FormatGitHub, ui.FormatText, ui.FormatGitHub))
+	cmd.PersistentFlags().Bool("quiet", false, "Suppress routine progress output")
+
+	v := internalconfig.Default()
+	v.SetDefault("no_color", false)
+	v.SetDefault("log_format", "")
+	v.SetDefault("quiet", false)
+	internalconfig.BindFlag(v, "no_color", cmd.PersistentFlags().Lookup("no-color"))
+	internalconfig.BindFlag(v, "log_format", cmd.PersistentFlags().Lookup("log-format"))
+	internalconfig.BindFlag(v, "quiet", cmd.PersistentFlags().Lookup("quiet"))
+
+	cmd.SetFlagErrorFunc(flagErrorFunc)
+
+	// Register commands from dev package
+	for _, c := range dev.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Register commands from env package
+	for _, c := range env.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Register commands from dagster package
+	for _, c := range dagster.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Add k8s subcommand (nested, not flat)
+	k8s.K8sCmd.GroupID = GroupKubernetes
+	cmd.AddCommand(k8s.K8sCmd)
+
+	// Register meta/maintenance commands
+	for _, c := range meta.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Add go subcommand for CLI development
+	meta.CliCmd.GroupID = GroupGoCLI
+	cmd.AddCommand(meta.CliCmd)
+
+	// Add config subcommand (nested, not flat)
+	config.ConfigCmd.GroupID = GroupConfiguration
+	for _, c := range config.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Register the completion command
+	for _, c := range completion.Commands() {
+		cmd.AddCommand(c)
+	}
+
+	// Install the grouped Management/Commands help template on cmd and every
+	// subcommand now that the whole tree is assembled, so e.g. `dap k8s
+	// --help` renders the same way as `dap --help`.
+	clitemplate.InstallTemplates(cmd)
+}
+
+// flagErrorFunc wraps cobra flag-parse failures in an errs.StatusError so
+// they get the same exit-code-125 treatment as any other usage error, with
+// a Docker-style "See '<path> --help'." hint pointing at the exact
+// subcommand that rejected the flag.
+func flagErrorFunc(cmd *cobra.Command, err error) error {
+	return &errs.StatusError{
+		Message: err.Error(),
+		Code:    errs.CodeUsage,
+		Cause:   err,
+		Hint:    fmt.Sprintf("See '%s --help'.", cmd.CommandPath()),
+	}
+}
+
+// Execute runs the root command with a context that's cancelled on
+// SIGINT/SIGTERM, so a subcommand shelling out via exec.RunPassthroughContext
+// or exec.RunInteractiveContext (cmd.Context()) can kill its child's whole
+// process group instead of leaving it running after dap itself exits. On
+// failure it renders the error through ui and exits with a stable exit
+// code, so scripts and CI get meaningful statuses instead of a flat 1. It
+// inspects the error chain for dap's own errs.StatusError first, since that
+// carries the documented exit code and any follow-up command suggestions;
+// an exec.StatusError (a raw child process failure not wrapped by a
+// subcommand) is handled next; anything else falls back to a generic
+// failure.
+func Execute() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := rootCmd.ExecuteContext(ctx)
+	if err == nil {
+		return
+	}
+
+	var dapErr *errs.StatusError
+	if errors.As(err, &dapErr) {
+		ui.ErrorBox(dapErr.Message, err.Error())
+		if dapErr.Hint != "" {
+			ui.Hint(dapErr.Hint)
+		}
+		for _, s := range dapErr.Suggestions {
+			ui.CommandHint(s, "try this next")
+		}
+		os.Exit(dapErr.Code)
+	}
+
+	var statusErr *exec.StatusError
+	if errors.As(err, &statusErr) {
+		ui.Error(statusErr.Status)
+		if statusErr.Hint != "" {
+			ui.Hint(statusErr.Hint)
+		}
+		os.Exit(statusErr.ExitCode)
+	}
+
+	ui.Error(err.Error())
+	os.Exit(1)
+}
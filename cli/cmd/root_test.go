@@ -29,6 +29,8 @@ func TestRootCommandGroups(t *testing.T) {
 		GroupDagster,
 		GroupKubernetes,
 		GroupGoCLI,
+		GroupConfiguration,
+		GroupShell,
 	}
 
 	if len(groups) != len(expectedGroups) {
@@ -92,6 +94,7 @@ func TestGroupConstants(t *testing.T) {
 		{"GroupDagster", GroupDagster, "dagster"},
 		{"GroupKubernetes", GroupKubernetes, "kubernetes"},
 		{"GroupGoCLI", GroupGoCLI, "gocli"},
+		{"GroupConfiguration", GroupConfiguration, "configuration"},
 	}
 
 	for _, tt := range tests {
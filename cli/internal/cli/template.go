@@ -0,0 +1,160 @@
+// Package cli provides the cobra help/usage template shared by dap's whole
+// command tree, so `dap --help` and `dap k8s --help` render the same way:
+// one section per declared group, listing its management commands
+// (subcommands that are themselves a parent, e.g. k8s) before its leaf
+// commands under the group's title, without separate headings; a command
+// with no declared groups gets Docker-style "Management Commands" and
+// "Commands" sections instead (e.g. logs under k8s). Flag help is wrapped
+// to the current terminal width instead of cobra's fixed default.
+package cli
+
+import (
+	"text/template"
+
+	"github.com/eth-library/dap/cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// Template is installed as both the UsageTemplate and HelpTemplate on
+// rootCmd and, via InstallTemplates, every command it registers.
+const Template = `Usage:{{if .Runnable}}
+  {{.UseLine}}{{end}}{{if .HasAvailableSubCommands}}
+  {{.CommandPath}} [command]{{end}}{{if gt (len .Aliases) 0}}
+
+Aliases:
+  {{.NameAndAliases}}{{end}}{{if .HasExample}}
+
+Examples:
+{{.Example}}{{end}}{{if hasGroups .}}{{range .Groups}}
+{{.Title}}{{range groupManagementCommands $ .}}
+  {{rpad .Name .NamePadding}} {{.Short}}{{end}}{{range groupOperationCommands $ .}}
+  {{rpad .Name .NamePadding}} {{.Short}}{{end}}
+{{end}}{{else}}{{if managementSubCommands .}}
+Management Commands:{{range managementSubCommands .}}
+  {{rpad .Name .NamePadding}} {{.Short}}{{end}}
+{{end}}{{if operationSubCommands .}}
+Commands:{{range operationSubCommands .}}
+  {{rpad .Name .NamePadding}} {{.Short}}{{end}}
+{{end}}{{end}}{{if .HasAvailableLocalFlags}}
+Flags:
+{{wrappedFlagUsages .}}{{end}}{{if .HasAvailableInheritedFlags}}
+Global Flags:
+{{wrappedInheritedFlagUsages .}}{{end}}{{if hasHelpSubCommands .}}
+Additional help topics:{{range .Commands}}{{if .IsAdditionalHelpTopicCommand}}
+  {{rpad .CommandPath .CommandPathPadding}} {{.Short}}{{end}}{{end}}
+{{end}}{{if .HasAvailableSubCommands}}
+Use "{{.CommandPath}} [command] --help" for more information about a command.{{end}}
+`
+
+// InstallTemplates sets Template as both the usage and help template on cmd
+// and, recursively, every command it currently has registered. A subcommand
+// added later still renders with Template, since cobra falls back to the
+// nearest ancestor with a template set, but it doesn't have Template set on
+// itself.
+func InstallTemplates(cmd *cobra.Command) {
+	cmd.SetUsageTemplate(Template)
+	cmd.SetHelpTemplate(Template)
+	for _, c := range cmd.Commands() {
+		InstallTemplates(c)
+	}
+}
+
+// init registers the template funcs above on cobra's template.FuncMap, which every
+// command's usage/help template shares process-wide.
+func init() {
+	cobra.AddTemplateFuncs(template.FuncMap{
+		"hasGroups":                  hasGroups,
+		"groupManagementCommands":    groupManagementCommands,
+		"groupOperationCommands":     groupOperationCommands,
+		"managementSubCommands":      managementSubCommands,
+		"operationSubCommands":       operationSubCommands,
+		"hasHelpSubCommands":         hasHelpSubCommands,
+		"wrappedFlagUsages":          wrappedFlagUsages,
+		"wrappedInheritedFlagUsages": wrappedInheritedFlagUsages,
+	})
+}
+
+// hasGroups reports whether cmd declares any cobra command groups. Commands
+// with no groups (e.g. k8s, whose subcommands are all leaves) fall back to a
+// plain Management/Commands split instead of per-group sections.
+func hasGroups(cmd *cobra.Command) bool {
+	return len(cmd.Groups()) > 0
+}
+
+// groupManagementCommands returns cmd's available subcommands in group g
+// that are themselves a parent for other subcommands.
+func groupManagementCommands(cmd *cobra.Command, g *cobra.Group) []*cobra.Command {
+	var out []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.GroupID == g.ID && c.IsAvailableCommand() && c.HasAvailableSubCommands() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// groupOperationCommands returns cmd's available subcommands in group g that
+// run directly, i.e. have no subcommands of their own.
+func groupOperationCommands(cmd *cobra.Command, g *cobra.Group) []*cobra.Command {
+	var out []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.GroupID == g.ID && c.IsAvailableCommand() && !c.HasAvailableSubCommands() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// managementSubCommands returns cmd's available subcommands that are
+// themselves a parent for other subcommands (e.g. k8s under root).
+func managementSubCommands(cmd *cobra.Command) []*cobra.Command {
+	var out []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && c.HasAvailableSubCommands() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// operationSubCommands returns cmd's available subcommands that run
+// directly (e.g. logs, shell under k8s).
+func operationSubCommands(cmd *cobra.Command) []*cobra.Command {
+	var out []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && !c.HasAvailableSubCommands() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func hasHelpSubCommands(cmd *cobra.Command) bool {
+	for _, c := range cmd.Commands() {
+		if c.IsAdditionalHelpTopicCommand() {
+			return true
+		}
+	}
+	return false
+}
+
+// wrappedFlagUsages renders cmd's local flags wrapped to the current
+// terminal width instead of pflag's fixed default.
+func wrappedFlagUsages(cmd *cobra.Command) string {
+	return trimTrailingWhitespace(cmd.LocalFlags().FlagUsagesWrapped(ui.TerminalWidth()))
+}
+
+// wrappedInheritedFlagUsages renders cmd's inherited flags wrapped to the
+// current terminal width.
+func wrappedInheritedFlagUsages(cmd *cobra.Command) string {
+	return trimTrailingWhitespace(cmd.InheritedFlags().FlagUsagesWrapped(ui.TerminalWidth()))
+}
+
+// trimTrailingWhitespace mirrors cobra's own template func of the same name,
+// which isn't exported for reuse.
+func trimTrailingWhitespace(s string) string {
+	for len(s) > 0 && (s[len(s)-1] == '\n' || s[len(s)-1] == ' ' || s[len(s)-1] == '\t') {
+		s = s[:len(s)-1]
+	}
+	return s
+}
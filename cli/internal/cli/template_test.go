@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newTestCmd(use string, groupID string, hasSubcommands bool) *cobra.Command {
+	cmd := &cobra.Command{Use: use, Short: use + " short", GroupID: groupID}
+	if hasSubcommands {
+		cmd.AddCommand(&cobra.Command{Use: "child", Short: "child", Run: func(*cobra.Command, []string) {}})
+	} else {
+		cmd.Run = func(*cobra.Command, []string) {}
+	}
+	return cmd
+}
+
+func TestHasGroups(t *testing.T) {
+	grouped := &cobra.Command{Use: "root"}
+	grouped.AddGroup(&cobra.Group{ID: "g", Title: "G:"})
+	if !hasGroups(grouped) {
+		t.Error("hasGroups() = false, want true for a command with a declared group")
+	}
+
+	ungrouped := &cobra.Command{Use: "root"}
+	if hasGroups(ungrouped) {
+		t.Error("hasGroups() = true, want false for a command with no declared groups")
+	}
+}
+
+func TestGroupManagementAndOperationCommands(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	g := &cobra.Group{ID: "g", Title: "G:"}
+	root.AddGroup(g)
+	other := &cobra.Group{ID: "other", Title: "Other:"}
+	root.AddGroup(other)
+
+	mgmt := newTestCmd("k8s", "g", true)
+	op := newTestCmd("logs", "g", false)
+	otherOp := newTestCmd("clean", "other", false)
+	root.AddCommand(mgmt, op, otherOp)
+
+	gotMgmt := groupManagementCommands(root, g)
+	if len(gotMgmt) != 1 || gotMgmt[0].Name() != "k8s" {
+		t.Errorf("groupManagementCommands() = %v, want [k8s]", gotMgmt)
+	}
+
+	gotOp := groupOperationCommands(root, g)
+	if len(gotOp) != 1 || gotOp[0].Name() != "logs" {
+		t.Errorf("groupOperationCommands() = %v, want [logs]", gotOp)
+	}
+}
+
+func TestManagementAndOperationSubCommands(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	mgmt := newTestCmd("k8s", "", true)
+	op := newTestCmd("logs", "", false)
+	root.AddCommand(mgmt, op)
+
+	gotMgmt := managementSubCommands(root)
+	if len(gotMgmt) != 1 || gotMgmt[0].Name() != "k8s" {
+		t.Errorf("managementSubCommands() = %v, want [k8s]", gotMgmt)
+	}
+
+	gotOp := operationSubCommands(root)
+	if len(gotOp) != 1 || gotOp[0].Name() != "logs" {
+		t.Errorf("operationSubCommands() = %v, want [logs]", gotOp)
+	}
+}
+
+func TestHasHelpSubCommands(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	root.AddCommand(newTestCmd("logs", "", false))
+	if hasHelpSubCommands(root) {
+		t.Error("hasHelpSubCommands() = true, want false with no additional help topic commands")
+	}
+
+	root.AddCommand(&cobra.Command{Use: "topics", Short: "topics"})
+	if !hasHelpSubCommands(root) {
+		t.Error("hasHelpSubCommands() = false, want true once an additional help topic command is present")
+	}
+}
+
+func TestWrappedFlagUsagesTrimsTrailingWhitespace(t *testing.T) {
+	cmd := &cobra.Command{Use: "root"}
+	cmd.Flags().Bool("flag", false, "a flag")
+	cmd.PersistentFlags().Bool("inherited", false, "an inherited flag")
+	child := &cobra.Command{Use: "child"}
+	cmd.AddCommand(child)
+
+	out := wrappedFlagUsages(cmd)
+	if out == "" {
+		t.Error("wrappedFlagUsages() = empty, want rendered flag usage")
+	}
+	if len(out) > 0 && (out[len(out)-1] == '\n' || out[len(out)-1] == ' ') {
+		t.Errorf("wrappedFlagUsages() = %q, want no trailing whitespace", out)
+	}
+
+	inherited := wrappedInheritedFlagUsages(child)
+	if inherited == "" {
+		t.Error("wrappedInheritedFlagUsages() = empty, want the parent's persistent flag")
+	}
+}
+
+func TestInstallTemplatesAppliesRecursively(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	child := &cobra.Command{Use: "child"}
+	grandchild := &cobra.Command{Use: "grandchild"}
+	child.AddCommand(grandchild)
+	root.AddCommand(child)
+
+	InstallTemplates(root)
+
+	for _, cmd := range []*cobra.Command{root, child, grandchild} {
+		if got := cmd.UsageTemplate(); got != Template {
+			t.Errorf("%s: UsageTemplate() not installed", cmd.Use)
+		}
+		if got := cmd.HelpTemplate(); got != Template {
+			t.Errorf("%s: HelpTemplate() not installed", cmd.Use)
+		}
+	}
+}
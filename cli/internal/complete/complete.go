@@ -0,0 +1,97 @@
+// Package complete provides shared building blocks for cobra
+// ValidArgsFunction and RegisterFlagCompletionFunc implementations: a
+// short-TTL, in-memory cache around slow shell-outs (kubectl, dagster,
+// docker), and a helper that degrades to "no completions" instead of
+// erroring when the backing tool isn't on PATH. The cache lives only as long
+// as the dap process; each tab press runs a new process, so results are not
+// shared between presses.
+package complete
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eth-library/dap/cli/internal/exec"
+	"github.com/spf13/cobra"
+)
+
+// defaultTTL bounds how long a cached shell-out result is reused within one
+// dap process.
+const defaultTTL = 5 * time.Second
+
+type cacheEntry struct {
+	out string
+	at  time.Time
+}
+
+var (
+	mu    sync.Mutex
+	cache = map[string]cacheEntry{}
+)
+
+// cached returns the cached result for key if it's younger than defaultTTL,
+// otherwise calls fetch and caches the result.
+func cached(key string, fetch func() string) string {
+	mu.Lock()
+	if entry, ok := cache[key]; ok && time.Since(entry.at) < defaultTTL {
+		mu.Unlock()
+		return entry.out
+	}
+	mu.Unlock()
+
+	out := fetch()
+
+	mu.Lock()
+	cache[key] = cacheEntry{out: out, at: time.Now()}
+	mu.Unlock()
+
+	return out
+}
+
+// ShellOutRaw runs name with args and caches its trimmed stdout for
+// defaultTTL under a key derived from name+args. If name isn't on PATH or
+// the command fails, it returns ("", false) rather than an error, so
+// completion degrades to "no suggestions" instead of printing a shell-out
+// failure into the user's terminal.
+func ShellOutRaw(name string, args ...string) (string, bool) {
+	if !exec.Which(name) {
+		return "", false
+	}
+
+	key := name + " " + strings.Join(args, " ")
+	out := cached(key, func() string {
+		out, err := exec.Run(name, args...)
+		if err != nil {
+			return ""
+		}
+		return out
+	})
+	return out, out != ""
+}
+
+// ShellOut runs name with args via ShellOutRaw and splits the output into
+// non-empty lines.
+func ShellOut(name string, args ...string) []string {
+	out, ok := ShellOutRaw(name, args...)
+	if !ok {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Func adapts a func(toComplete string) []string into a cobra
+// ValidArgsFunction/RegisterFlagCompletionFunc callback, always suppressing
+// cobra's default filename-completion fallback.
+func Func(fetch func(toComplete string) []string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return fetch(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
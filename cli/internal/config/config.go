@@ -0,0 +1,103 @@
+// Package config centralizes Viper-backed configuration for dap.
+//
+// Values resolve in order of precedence: explicit CLI flag, then DAP_*
+// environment variable, then a config file, then the compiled-in default set
+// via SetDefault. The config file is the first of $DAP_CONFIG, ./.dap.yaml,
+// or $XDG_CONFIG_HOME/dap/config.yaml (~/.config/dap/config.yaml) that
+// exists. Packages that used to hard-code constants (k8s.Namespace,
+// dev.PythonTargets, ...) call Default() once at init time to get a shared
+// Viper instance, register their defaults on it, and bind any flags that
+// should be allowed to override it.
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+var (
+	once sync.Once
+	v    *viper.Viper
+)
+
+// Default returns the process-wide Viper instance, creating and loading it
+// on first use.
+func Default() *viper.Viper {
+	once.Do(func() {
+		v = New()
+	})
+	return v
+}
+
+// New builds a fresh Viper instance configured with dap's env var prefix and
+// config file search path. Most callers should use Default(); New() exists so
+// tests can exercise config resolution in isolation.
+func New() *viper.Viper {
+	nv := viper.New()
+
+	nv.SetEnvPrefix("DAP")
+	nv.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	nv.AutomaticEnv()
+
+	nv.SetConfigType("yaml")
+
+	if path := configFilePath(); path != "" {
+		nv.SetConfigFile(path)
+		// A config file that exists but fails to parse still shouldn't take
+		// down the whole CLI; defaults and env vars still apply.
+		_ = nv.ReadInConfig()
+	}
+
+	return nv
+}
+
+// configFilePath resolves which config file dap should load, in order of
+// precedence: an explicit $DAP_CONFIG path, ./.dap.yaml in the current
+// directory (a project-local override), then DefaultConfigFile(). It returns
+// "" if none of these exist, which is expected on a fresh machine.
+func configFilePath() string {
+	if explicit := os.Getenv("DAP_CONFIG"); explicit != "" {
+		return explicit
+	}
+	if _, err := os.Stat(".dap.yaml"); err == nil {
+		return ".dap.yaml"
+	}
+	if _, err := os.Stat(DefaultConfigFile()); err == nil {
+		return DefaultConfigFile()
+	}
+	return ""
+}
+
+// DefaultConfigFile returns $XDG_CONFIG_HOME/dap/config.yaml, falling back
+// to ~/.config/dap/config.yaml. It's the path `dap config init` creates and
+// the last place New() looks for a config file.
+func DefaultConfigFile() string {
+	return filepath.Join(configDir(), "config.yaml")
+}
+
+// configDir returns $XDG_CONFIG_HOME/dap, falling back to ~/.config/dap.
+func configDir() string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "dap")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".config/dap"
+	}
+	return filepath.Join(home, ".config", "dap")
+}
+
+// BindFlag binds flag to key on v so an explicit CLI flag value takes
+// precedence over the env var / config file / default chain. It is a no-op
+// if flag is nil, so callers can look up optional flags without a guard.
+func BindFlag(v *viper.Viper, key string, flag *pflag.Flag) {
+	if flag == nil {
+		return
+	}
+	_ = v.BindPFlag(key, flag)
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewAppliesEnvVarOverride(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("DAP_K8S_NAMESPACE", "from-env")
+
+	v := New()
+	v.SetDefault("k8s.namespace", "dagster")
+
+	if got := v.GetString("k8s.namespace"); got != "from-env" {
+		t.Errorf("GetString(k8s.namespace) = %q, want %q", got, "from-env")
+	}
+}
+
+func TestNewFallsBackToDefault(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	os.Unsetenv("DAP_K8S_NAMESPACE")
+
+	v := New()
+	v.SetDefault("k8s.namespace", "dagster")
+
+	if got := v.GetString("k8s.namespace"); got != "dagster" {
+		t.Errorf("GetString(k8s.namespace) = %q, want %q", got, "dagster")
+	}
+}
+
+func TestNewReadsConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "dap")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("k8s:\n  namespace: from-file\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	os.Unsetenv("DAP_K8S_NAMESPACE")
+
+	v := New()
+	v.SetDefault("k8s.namespace", "dagster")
+
+	if got := v.GetString("k8s.namespace"); got != "from-file" {
+		t.Errorf("GetString(k8s.namespace) = %q, want %q", got, "from-file")
+	}
+}
+
+func TestNewPrefersDotDapYamlInCwd(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".dap.yaml"), []byte("k8s:\n  namespace: from-local\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(orig)
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	os.Unsetenv("DAP_CONFIG")
+	os.Unsetenv("DAP_K8S_NAMESPACE")
+
+	v := New()
+	v.SetDefault("k8s.namespace", "dagster")
+
+	if got := v.GetString("k8s.namespace"); got != "from-local" {
+		t.Errorf("GetString(k8s.namespace) = %q, want %q", got, "from-local")
+	}
+}
+
+func TestNewExplicitConfigPathOverridesSearch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "custom.yaml")
+	if err := os.WriteFile(path, []byte("k8s:\n  namespace: from-explicit\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("DAP_CONFIG", path)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	os.Unsetenv("DAP_K8S_NAMESPACE")
+
+	v := New()
+	v.SetDefault("k8s.namespace", "dagster")
+
+	if got := v.GetString("k8s.namespace"); got != "from-explicit" {
+		t.Errorf("GetString(k8s.namespace) = %q, want %q", got, "from-explicit")
+	}
+}
+
+func TestDefaultConfigFileJoinsXDGAndFilename(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg-home")
+
+	want := filepath.Join("/tmp/xdg-home", "dap", "config.yaml")
+	if got := DefaultConfigFile(); got != want {
+		t.Errorf("DefaultConfigFile() = %q, want %q", got, want)
+	}
+}
+
+func TestBindFlagNilIsNoOp(t *testing.T) {
+	v := New()
+	BindFlag(v, "k8s.namespace", nil)
+}
@@ -0,0 +1,132 @@
+// Package errs provides dap's domain-level error type and the stable exit
+// code table CI systems rely on to tell transient (network/k8s) failures
+// apart from permanent (lint/type/test) ones. It sits above internal/exec,
+// which reports the exit code of a single child process; errs.StatusError
+// reports the outcome of a whole dap subcommand, often wrapping one or more
+// exec errors as its Cause.
+package errs
+
+import "fmt"
+
+// Exit codes returned by dap on failure. 0 (success) and 1 (unclassified
+// error) follow normal Unix convention; the rest are dap-specific and are
+// part of the documented CLI contract, so do not renumber them.
+const (
+	CodeGeneric         = 1
+	CodeUsage           = 125 // bad flags/args, same family cobra itself uses
+	CodeK8sUnavailable  = 2
+	CodeDockerBuild     = 3
+	CodeRolloutTimeout  = 4
+	CodeLintFailed      = 5
+	CodeTypecheckFailed = 6
+	CodeTestFailed      = 7
+	CodeDoctorFailed    = 8
+)
+
+// StatusError is a dap subcommand failure with a stable exit code, an
+// optional underlying cause, and optional follow-up hints for the user.
+type StatusError struct {
+	Message     string
+	Code        int
+	Cause       error
+	Hint        string
+	Suggestions []string
+}
+
+func (e *StatusError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Cause
+}
+
+// ErrK8sUnavailable reports that the configured Kubernetes context could not
+// be reached.
+func ErrK8sUnavailable(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Kubernetes cluster unavailable",
+		Code:        CodeK8sUnavailable,
+		Cause:       cause,
+		Hint:        "is the cluster running and is your kubectl context set correctly?",
+		Suggestions: []string{"dap k8s diag"},
+	}
+}
+
+// ErrDockerBuild reports that `docker build` failed.
+func ErrDockerBuild(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Docker build failed",
+		Code:        CodeDockerBuild,
+		Cause:       cause,
+		Suggestions: []string{"dap k8s diag"},
+	}
+}
+
+// ErrRolloutTimeout reports that a Kubernetes rollout did not become ready
+// within its configured timeout.
+func ErrRolloutTimeout(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Rollout did not become ready in time",
+		Code:        CodeRolloutTimeout,
+		Cause:       cause,
+		Hint:        "increase k8s.rollout_timeout or inspect the pods directly",
+		Suggestions: []string{"dap k8s wait", "dap k8s diag"},
+	}
+}
+
+// ErrLintFailed reports that ruff reported style or formatting issues.
+func ErrLintFailed(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Lint check failed",
+		Code:        CodeLintFailed,
+		Cause:       cause,
+		Suggestions: []string{"dap lint --fix"},
+	}
+}
+
+// ErrTypecheckFailed reports that mypy reported type errors.
+func ErrTypecheckFailed(cause error) *StatusError {
+	return &StatusError{
+		Message: "Type check failed",
+		Code:    CodeTypecheckFailed,
+		Cause:   cause,
+	}
+}
+
+// ErrTestFailed reports that the pytest suite failed.
+func ErrTestFailed(cause error) *StatusError {
+	return &StatusError{
+		Message: "Tests failed",
+		Code:    CodeTestFailed,
+		Cause:   cause,
+	}
+}
+
+// ErrDoctorFailed reports that one or more tools are missing or older than
+// dap's minimum supported version.
+func ErrDoctorFailed(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Tool requirements not met",
+		Code:        CodeDoctorFailed,
+		Cause:       cause,
+		Suggestions: []string{"dap env doctor"},
+	}
+}
+
+// ErrManifestDrift reports that the committed cli-manifest.json no longer
+// matches the in-binary command tree. It's a CI/tooling check rather than a
+// runtime failure category, so unlike the errors above it doesn't get a
+// dedicated exit code - CodeGeneric is enough to distinguish it from success.
+func ErrManifestDrift(cause error) *StatusError {
+	return &StatusError{
+		Message:     "Command manifest is out of date",
+		Code:        CodeGeneric,
+		Cause:       cause,
+		Hint:        "regenerate it and commit the result",
+		Suggestions: []string{"go generate ./..."},
+	}
+}
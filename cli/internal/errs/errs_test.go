@@ -0,0 +1,38 @@
+package errs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStatusErrorUnwrap(t *testing.T) {
+	cause := errors.New("boom")
+	err := ErrDockerBuild(cause)
+
+	if !errors.Is(err, cause) {
+		t.Error("errors.Is should find the wrapped cause")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatal("errors.As should find the StatusError")
+	}
+	if statusErr.Code != CodeDockerBuild {
+		t.Errorf("Code = %d, want %d", statusErr.Code, CodeDockerBuild)
+	}
+}
+
+func TestStatusErrorMessage(t *testing.T) {
+	err := ErrLintFailed(errors.New("ruff exited 1"))
+	want := "Lint check failed: ruff exited 1"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestStatusErrorWithoutCause(t *testing.T) {
+	err := &StatusError{Message: "generic failure", Code: CodeGeneric}
+	if got := err.Error(); got != "generic failure" {
+		t.Errorf("Error() = %q, want %q", got, "generic failure")
+	}
+}
@@ -0,0 +1,207 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ringBufferLimit bounds how much of a job's output Group keeps around for
+// its Result.Output - enough to show the tail of a runaway command without
+// letting a noisy job grow unbounded in memory.
+const ringBufferLimit = 64 * 1024
+
+// ringBuffer keeps only the most recent ringBufferLimit bytes written to it.
+type ringBuffer struct {
+	data []byte
+}
+
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	r.data = append(r.data, p...)
+	if over := len(r.data) - ringBufferLimit; over > 0 {
+		r.data = r.data[over:]
+	}
+	return len(p), nil
+}
+
+func (r *ringBuffer) String() string {
+	return string(r.data)
+}
+
+// Job is one named command to run as part of a Group. Run receives the
+// group's (possibly cancelled) context and the job's own stdout/stderr,
+// streamed to the real os.Stdout/os.Stderr under a shared heading; most Run
+// funcs will just shell out via RunContext with these writers.
+type Job struct {
+	Name string
+	Run  func(ctx context.Context, stdout, stderr io.Writer) error
+}
+
+// Result is the outcome of running one Job within a Group.
+type Result struct {
+	Job      Job
+	Output   string
+	Err      error
+	ExitCode int
+	Elapsed  time.Duration
+}
+
+// GroupOptions configures RunGroup.
+type GroupOptions struct {
+	// Concurrency caps how many jobs run at once. 1 runs jobs one at a time
+	// in the order given - the old sequential behavior. <= 0 means
+	// unbounded (all jobs start immediately).
+	Concurrency int
+	// FailFast cancels every other job's context as soon as one job fails.
+	FailFast bool
+}
+
+// RunGroup runs jobs concurrently, bounded by opts.Concurrency, and returns
+// one Result per job in the same order as jobs. Each job's stdout and
+// stderr are streamed to the real os.Stdout/os.Stderr as they arrive, under
+// a heading that's only reprinted when the active job changes - so a burst
+// of output from one job reads as a contiguous block even while other jobs
+// are interleaved around it - and a mutex shared across both streams
+// guarantees two jobs' lines are never spliced together mid-line.
+func RunGroup(ctx context.Context, jobs []Job, opts GroupOptions) []Result {
+	n := opts.Concurrency
+	if n <= 0 || n > len(jobs) {
+		n = len(jobs)
+	}
+
+	groupCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	lastHeading := ""
+	heading := func(name string) {
+		if lastHeading != name {
+			fmt.Fprintf(os.Stdout, "\n--- %s ---\n", name)
+			lastHeading = name
+		}
+	}
+
+	results := make([]Result, len(jobs))
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+
+	for i, job := range jobs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, job Job) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			buf := &ringBuffer{}
+			stdout := &lineWriter{emit: func(line []byte) {
+				mu.Lock()
+				defer mu.Unlock()
+				heading(job.Name)
+				os.Stdout.Write(line)
+				buf.Write(line)
+			}}
+			stderr := &lineWriter{emit: func(line []byte) {
+				mu.Lock()
+				defer mu.Unlock()
+				heading(job.Name)
+				os.Stderr.Write(line)
+				buf.Write(line)
+			}}
+
+			err := job.Run(groupCtx, stdout, stderr)
+			stdout.flush()
+			stderr.flush()
+
+			exitCode := 0
+			if err != nil {
+				if opts.FailFast {
+					cancel()
+				}
+				exitCode = exitCodeOf(err)
+			}
+
+			results[i] = Result{Job: job, Output: buf.String(), Err: err, ExitCode: exitCode, Elapsed: time.Since(start)}
+		}(i, job)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// exitCodeOf returns a Job's real exit code, unwrapping a *StatusError if
+// job.Run returned one (as RunContext's do); any other error is reported as
+// exit code 1, matching newStatusError's own fallback.
+func exitCodeOf(err error) int {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.ExitCode
+	}
+	return 1
+}
+
+// RunContext is RunPassthrough's context-aware counterpart: it runs a
+// command to completion, writing its stdout/stderr to the given writers
+// instead of directly to the process's own streams, and stops it (returning
+// the usual "interrupted" StatusError, see newStatusError) if ctx is
+// cancelled first.
+//
+// The command runs in its own process group, and cancellation kills that
+// whole group rather than just the direct child. ruff/mypy/pytest are
+// sometimes reached through a wrapper (a venv console-script shim, `poetry
+// run`, ...); killing only the wrapper leaves its real child running, which
+// also leaves Wait blocked on that grandchild's still-open stdout/stderr
+// pipe. WaitDelay bounds that wait in case a process escapes the group
+// regardless, e.g. by deliberately detaching itself.
+func RunContext(ctx context.Context, stdout, stderr io.Writer, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = 2 * time.Second
+	if err := cmd.Run(); err != nil {
+		return newStatusError(name, err)
+	}
+	return nil
+}
+
+// lineWriter buffers partial lines so every call to emit carries only whole
+// lines. Group's jobs run concurrently and share os.Stdout; without this, a
+// single Write from one job's command could be split across two Write
+// syscalls and interleave with another job's output mid-line even under a
+// mutex, since the mutex only protects each individual emit call.
+type lineWriter struct {
+	emit func(line []byte)
+	buf  []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(w.buf[:i+1])
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+// flush emits any trailing partial line left once the command has exited.
+func (w *lineWriter) flush() {
+	if len(w.buf) > 0 {
+		w.emit(w.buf)
+		w.buf = nil
+	}
+}
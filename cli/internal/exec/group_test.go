@@ -0,0 +1,144 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunGroupAllSucceed(t *testing.T) {
+	jobs := []Job{
+		{Name: "a", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return RunContext(ctx, stdout, stderr, "echo", "hello from a")
+		}},
+		{Name: "b", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return RunContext(ctx, stdout, stderr, "echo", "hello from b")
+		}},
+	}
+
+	results := RunGroup(context.Background(), jobs, GroupOptions{})
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Errorf("results[%d].Err = %v, want nil", i, r.Err)
+		}
+		if r.ExitCode != 0 {
+			t.Errorf("results[%d].ExitCode = %d, want 0", i, r.ExitCode)
+		}
+	}
+	if !strings.Contains(results[0].Output, "hello from a") {
+		t.Errorf("results[0].Output = %q, want to contain %q", results[0].Output, "hello from a")
+	}
+	if !strings.Contains(results[1].Output, "hello from b") {
+		t.Errorf("results[1].Output = %q, want to contain %q", results[1].Output, "hello from b")
+	}
+}
+
+func TestRunGroupReportsExitCode(t *testing.T) {
+	jobs := []Job{
+		{Name: "fails", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return RunContext(ctx, stdout, stderr, "sh", "-c", "exit 5")
+		}},
+	}
+
+	results := RunGroup(context.Background(), jobs, GroupOptions{})
+	if results[0].Err == nil {
+		t.Fatal("results[0].Err = nil, want an error")
+	}
+	if results[0].ExitCode != 5 {
+		t.Errorf("results[0].ExitCode = %d, want 5", results[0].ExitCode)
+	}
+}
+
+func TestRunGroupConcurrencyOneIsSequential(t *testing.T) {
+	var order []string
+	var mu sync.Mutex
+
+	jobs := []Job{
+		{Name: "first", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			mu.Lock()
+			order = append(order, "first")
+			mu.Unlock()
+			return nil
+		}},
+		{Name: "second", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			mu.Lock()
+			order = append(order, "second")
+			mu.Unlock()
+			return nil
+		}},
+	}
+
+	RunGroup(context.Background(), jobs, GroupOptions{Concurrency: 1})
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("order = %v, want [first second]", order)
+	}
+}
+
+func TestRunGroupFailFastCancelsSiblings(t *testing.T) {
+	jobs := []Job{
+		{Name: "fails-fast", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return errors.New("boom")
+		}},
+		{Name: "waits", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(10 * time.Second):
+				return nil
+			}
+		}},
+	}
+
+	results := RunGroup(context.Background(), jobs, GroupOptions{Concurrency: 2, FailFast: true})
+	if results[0].Err == nil {
+		t.Fatal("results[0].Err = nil, want an error")
+	}
+	if !errors.Is(results[1].Err, context.Canceled) {
+		t.Errorf("results[1].Err = %v, want context.Canceled", results[1].Err)
+	}
+}
+
+func TestRunGroupFailFastKillsWrappedSubprocessPromptly(t *testing.T) {
+	jobs := []Job{
+		{Name: "fails", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return RunContext(ctx, stdout, stderr, "bash", "-c", "exit 1")
+		}},
+		// A shell wrapping a long-running child mimics a venv console-script
+		// shim or `poetry run ...`: killing only the wrapper would leave the
+		// sleep running and Wait blocked on its still-open stdout pipe.
+		{Name: "wrapped", Run: func(ctx context.Context, stdout, stderr io.Writer) error {
+			return RunContext(ctx, stdout, stderr, "bash", "-c", "sleep 5; echo done")
+		}},
+	}
+
+	start := time.Now()
+	results := RunGroup(context.Background(), jobs, GroupOptions{Concurrency: 2, FailFast: true})
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("RunGroup took %v, want well under the wrapped job's 5s sleep", elapsed)
+	}
+	if results[1].Err == nil {
+		t.Error("results[1].Err = nil, want the wrapped job to report being killed")
+	}
+}
+
+func TestRunContextNotFound(t *testing.T) {
+	var buf strings.Builder
+	err := RunContext(context.Background(), &buf, &buf, "definitely-not-a-real-command-12345")
+	if err == nil {
+		t.Fatal("RunContext() with missing binary returned nil error")
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunContext() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 127 {
+		t.Errorf("ExitCode = %d, want 127", statusErr.ExitCode)
+	}
+}
@@ -3,11 +3,41 @@ package exec
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"strings"
+	"syscall"
+	"time"
 )
 
+// StatusError wraps a failed external command with its real exit code and an
+// actionable one-line hint, so the root command can render "kubectl not
+// found" instead of a bare "exit status 1".
+type StatusError struct {
+	// Status is a short, user-facing description of what failed.
+	Status string
+	// ExitCode is the child process's actual exit code.
+	ExitCode int
+	// Cause is the underlying error returned by os/exec.
+	Cause error
+	// Hint is an optional one-line suggestion for the user.
+	Hint string
+}
+
+func (e *StatusError) Error() string {
+	if e.Status != "" {
+		return e.Status
+	}
+	return e.Cause.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Cause
+}
+
 // Run executes a command and returns its combined output.
 // Returns empty string if the command fails.
 func Run(name string, args ...string) (string, error) {
@@ -28,16 +58,96 @@ func RunInteractive(name string, args ...string) error {
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	if err := cmd.Run(); err != nil {
+		return newStatusError(name, err)
+	}
+	return nil
 }
 
 // RunPassthrough runs a command, passing through all output to the terminal.
-// Returns the exit code.
 func RunPassthrough(name string, args ...string) error {
 	cmd := exec.Command(name, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	if err := cmd.Run(); err != nil {
+		return newStatusError(name, err)
+	}
+	return nil
+}
+
+// RunPassthroughContext is RunPassthrough's cancellable counterpart: if ctx
+// is cancelled (dap installs SIGINT/SIGTERM handling on it in cmd.Execute),
+// name's whole process group is killed rather than just name itself, via
+// the same mechanism as RunContext - see RunContext's doc comment for why a
+// plain exec.CommandContext cancellation isn't enough to avoid leaking a
+// wrapped grandchild (a venv console-script shim, `poetry run`, a Dagster
+// run worker, ...).
+func RunPassthroughContext(ctx context.Context, name string, args ...string) error {
+	return RunContext(ctx, os.Stdout, os.Stderr, name, args...)
+}
+
+// RunInteractiveContext is RunInteractive's cancellable counterpart. Commands
+// dap runs this way (`dagster dev`, `dagster job execute`, ...) are
+// long-running and know how to shut down cleanly on SIGINT - the same signal
+// a user's own Ctrl+C would have sent them directly, before Setpgid moved
+// them out of the terminal's foreground process group - so on cancellation
+// it sends SIGINT to name's whole process group first, falling back to the
+// group-wide SIGKILL used by RunContext/RunPassthroughContext only if name is
+// still alive a few seconds later.
+func RunInteractiveContext(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	var killTimer *time.Timer
+	cmd.Cancel = func() error {
+		pid := cmd.Process.Pid
+		if err := syscall.Kill(-pid, syscall.SIGINT); err != nil {
+			return err
+		}
+		killTimer = time.AfterFunc(5*time.Second, func() {
+			_ = syscall.Kill(-pid, syscall.SIGKILL)
+		})
+		return nil
+	}
+	cmd.WaitDelay = 10 * time.Second
+
+	err := cmd.Run()
+	if killTimer != nil {
+		killTimer.Stop()
+	}
+	if err != nil {
+		return newStatusError(name, err)
+	}
+	return nil
+}
+
+// RunCaptured runs a command to completion and returns its stdout and
+// stderr separately (each trimmed of surrounding whitespace) regardless of
+// whether it succeeds - unlike Run, which discards all output on a non-zero
+// exit and leaves the caller unable to show the user what the command
+// actually said. Cancellation kills name's whole process group, as in
+// RunPassthroughContext.
+func RunCaptured(ctx context.Context, name string, args ...string) (stdout, stderr string, err error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var outBuf, errBuf bytes.Buffer
+	cmd.Stdout = &outBuf
+	cmd.Stderr = &errBuf
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = 2 * time.Second
+
+	runErr := cmd.Run()
+	stdout = strings.TrimSpace(outBuf.String())
+	stderr = strings.TrimSpace(errBuf.String())
+	if runErr != nil {
+		return stdout, stderr, newStatusError(name, runErr)
+	}
+	return stdout, stderr, nil
 }
 
 // Which checks if a command exists in PATH.
@@ -45,3 +155,42 @@ func Which(name string) bool {
 	_, err := exec.LookPath(name)
 	return err == nil
 }
+
+// newStatusError classifies err (as returned by cmd.Run()) into a StatusError
+// carrying the child's real exit code and, where we can tell what went wrong,
+// a one-line hint.
+func newStatusError(name string, err error) *StatusError {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code := exitErr.ExitCode()
+		if code == -1 {
+			return &StatusError{
+				Status:   fmt.Sprintf("%s was interrupted", name),
+				ExitCode: 130,
+				Cause:    err,
+				Hint:     "the command was cancelled before it finished",
+			}
+		}
+		return &StatusError{
+			Status:   fmt.Sprintf("%s exited with code %d", name, code),
+			ExitCode: code,
+			Cause:    err,
+		}
+	}
+
+	var lookupErr *exec.Error
+	if errors.As(err, &lookupErr) {
+		return &StatusError{
+			Status:   fmt.Sprintf("%s not found", name),
+			ExitCode: 127,
+			Cause:    err,
+			Hint:     fmt.Sprintf("install %s and make sure it is on PATH", name),
+		}
+	}
+
+	return &StatusError{
+		Status:   fmt.Sprintf("failed to run %s", name),
+		ExitCode: 1,
+		Cause:    err,
+	}
+}
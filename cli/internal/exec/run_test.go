@@ -0,0 +1,205 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWhich(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected bool
+	}{
+		{"go exists", "go", true},
+		{"nonexistent command", "definitely-not-a-real-command-12345", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Which(tt.command)
+			if got != tt.expected {
+				t.Errorf("Which(%q) = %v, want %v", tt.command, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     string
+		args        []string
+		wantErr     bool
+		wantContain string
+	}{
+		{
+			name:        "echo command",
+			command:     "echo",
+			args:        []string{"hello"},
+			wantErr:     false,
+			wantContain: "hello",
+		},
+		{
+			name:    "nonexistent command",
+			command: "definitely-not-a-real-command-12345",
+			args:    []string{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Run(tt.command, tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && tt.wantContain != "" {
+				if got != tt.wantContain {
+					t.Errorf("Run() = %q, want to contain %q", got, tt.wantContain)
+				}
+			}
+		})
+	}
+}
+
+func TestRunPassthroughNotFound(t *testing.T) {
+	err := RunPassthrough("definitely-not-a-real-command-12345")
+	if err == nil {
+		t.Fatal("RunPassthrough() with missing binary returned nil error")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunPassthrough() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 127 {
+		t.Errorf("ExitCode = %d, want 127", statusErr.ExitCode)
+	}
+	if statusErr.Hint == "" {
+		t.Error("Hint is empty, want a suggestion to install the missing binary")
+	}
+}
+
+func TestRunPassthroughExitCode(t *testing.T) {
+	err := RunPassthrough("sh", "-c", "exit 3")
+	if err == nil {
+		t.Fatal("RunPassthrough() with failing command returned nil error")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunPassthrough() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 3 {
+		t.Errorf("ExitCode = %d, want 3", statusErr.ExitCode)
+	}
+}
+
+func TestRunInteractiveSuccess(t *testing.T) {
+	if err := RunInteractive("true"); err != nil {
+		t.Errorf("RunInteractive(\"true\") returned error: %v", err)
+	}
+}
+
+func TestRunPassthroughContextExitCode(t *testing.T) {
+	err := RunPassthroughContext(context.Background(), "sh", "-c", "exit 3")
+	if err == nil {
+		t.Fatal("RunPassthroughContext() with failing command returned nil error")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunPassthroughContext() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 3 {
+		t.Errorf("ExitCode = %d, want 3", statusErr.ExitCode)
+	}
+}
+
+func TestRunPassthroughContextKillsWrappedSubprocessPromptly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	// A shell wrapping a long-running child mimics a venv console-script shim
+	// or `poetry run ...`: killing only the wrapper would leave the sleep
+	// running and Wait blocked on its still-open stdout pipe.
+	err := RunPassthroughContext(ctx, "bash", "-c", "sleep 5; echo done")
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("RunPassthroughContext took %v, want well under the wrapped command's 5s sleep", elapsed)
+	}
+	if err == nil {
+		t.Error("RunPassthroughContext() = nil, want an error from being killed")
+	}
+}
+
+func TestRunInteractiveContextSuccess(t *testing.T) {
+	if err := RunInteractiveContext(context.Background(), "true"); err != nil {
+		t.Errorf("RunInteractiveContext(\"true\") returned error: %v", err)
+	}
+}
+
+func TestRunInteractiveContextExitCode(t *testing.T) {
+	err := RunInteractiveContext(context.Background(), "sh", "-c", "exit 4")
+	if err == nil {
+		t.Fatal("RunInteractiveContext() with failing command returned nil error")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunInteractiveContext() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 4 {
+		t.Errorf("ExitCode = %d, want 4", statusErr.ExitCode)
+	}
+}
+
+func TestRunCaptured(t *testing.T) {
+	stdout, stderr, err := RunCaptured(context.Background(), "sh", "-c", "echo out; echo err >&2")
+	if err != nil {
+		t.Fatalf("RunCaptured() returned error: %v", err)
+	}
+	if stdout != "out" {
+		t.Errorf("stdout = %q, want %q", stdout, "out")
+	}
+	if stderr != "err" {
+		t.Errorf("stderr = %q, want %q", stderr, "err")
+	}
+}
+
+func TestRunCapturedFailureIncludesStderr(t *testing.T) {
+	_, stderr, err := RunCaptured(context.Background(), "sh", "-c", "echo boom >&2; exit 1")
+	if err == nil {
+		t.Fatal("RunCaptured() with failing command returned nil error")
+	}
+	if stderr != "boom" {
+		t.Errorf("stderr = %q, want %q", stderr, "boom")
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunCaptured() error is %T, want *StatusError", err)
+	}
+	if statusErr.ExitCode != 1 {
+		t.Errorf("ExitCode = %d, want 1", statusErr.ExitCode)
+	}
+}
+
+func TestStatusErrorUnwrap(t *testing.T) {
+	err := RunPassthrough("sh", "-c", "exit 1")
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("RunPassthrough() error is %T, want *StatusError", err)
+	}
+	if statusErr.Unwrap() != statusErr.Cause {
+		t.Error("Unwrap() does not return Cause")
+	}
+}
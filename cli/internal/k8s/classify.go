@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Runner executes a command and returns its output, matching the signature
+// of exec.Run and of the diag subpackage's Runner.
+type Runner func(name string, args ...string) (string, error)
+
+// transientImageReasons are container waiting reasons that normally clear up
+// on their own on a dev cluster: the image is still being pulled, or the
+// pod is still being created.
+var transientImageReasons = map[string]time.Duration{
+	"ImagePullBackOff":  15 * time.Second,
+	"ErrImagePull":      10 * time.Second,
+	"ContainerCreating": 5 * time.Second,
+}
+
+// ClassifyRolloutFailure inspects why a `kubectl rollout status` for
+// selector in namespace failed and decides whether it's worth retrying.
+// cause is the error kubectl returned. A condition it recognizes as
+// transient comes back as *Retryable, anything else comes back as cause
+// unchanged, so it returns nil only when cause is nil and no transient
+// condition is found.
+func ClassifyRolloutFailure(run Runner, namespace, selector string, cause error) error {
+	if cause != nil && strings.Contains(strings.ToLower(cause.Error()), "connection refused") {
+		return &Retryable{After: 5 * time.Second, Reason: "cluster connection refused", Cause: cause}
+	}
+
+	out, err := run("kubectl", "get", "pods", "-n", namespace, "-l", selector, "-o", "json")
+	if err != nil {
+		// Can't even list pods right now; treat the same as a refused
+		// connection rather than giving up outright.
+		return &Retryable{After: 5 * time.Second, Reason: "cluster unreachable while checking pod status", Cause: cause}
+	}
+
+	if reason, after, ok := waitingReason(out); ok {
+		return &Retryable{After: after, Reason: "pod " + reason, Cause: cause}
+	}
+
+	return cause
+}
+
+func waitingReason(podsJSON string) (reason string, after time.Duration, ok bool) {
+	var list struct {
+		Items []struct {
+			Status struct {
+				ContainerStatuses []struct {
+					State struct {
+						Waiting struct {
+							Reason string `json:"reason"`
+						} `json:"waiting"`
+					} `json:"state"`
+				} `json:"containerStatuses"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	if json.Unmarshal([]byte(podsJSON), &list) != nil {
+		return "", 0, false
+	}
+
+	for _, item := range list.Items {
+		for _, cs := range item.Status.ContainerStatuses {
+			if after, known := transientImageReasons[cs.State.Waiting.Reason]; known {
+				return cs.State.Waiting.Reason, after, true
+			}
+		}
+	}
+	return "", 0, false
+}
@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func fakeRunner(responses map[string]string) Runner {
+	return func(name string, args ...string) (string, error) {
+		key := name + " " + strings.Join(args, " ")
+		if out, ok := responses[key]; ok {
+			return out, nil
+		}
+		return "", fmt.Errorf("no stub for %q", key)
+	}
+}
+
+func TestClassifyRolloutFailureConnectionRefused(t *testing.T) {
+	cause := errors.New("dial tcp: connection refused")
+	run := fakeRunner(nil)
+
+	err := ClassifyRolloutFailure(run, "dagster", "app=foo", cause)
+
+	var retryable *Retryable
+	if !errors.As(err, &retryable) {
+		t.Fatalf("ClassifyRolloutFailure() = %T, want *Retryable", err)
+	}
+	if retryable.Cause != cause {
+		t.Error("Retryable.Cause does not match the original error")
+	}
+}
+
+func TestClassifyRolloutFailureImagePullBackOff(t *testing.T) {
+	cause := errors.New("timed out waiting for the condition")
+	run := fakeRunner(map[string]string{
+		"kubectl get pods -n dagster -l app=foo -o json": `{
+			"items": [{"status": {"containerStatuses": [
+				{"state": {"waiting": {"reason": "ImagePullBackOff"}}}
+			]}}]
+		}`,
+	})
+
+	err := ClassifyRolloutFailure(run, "dagster", "app=foo", cause)
+
+	var retryable *Retryable
+	if !errors.As(err, &retryable) {
+		t.Fatalf("ClassifyRolloutFailure() = %T, want *Retryable", err)
+	}
+	if retryable.After <= 0 {
+		t.Error("Retryable.After should be positive")
+	}
+}
+
+func TestClassifyRolloutFailureTerminal(t *testing.T) {
+	cause := errors.New("deployment spec is invalid")
+	run := fakeRunner(map[string]string{
+		"kubectl get pods -n dagster -l app=foo -o json": `{
+			"items": [{"status": {"containerStatuses": [
+				{"state": {"waiting": {"reason": "CrashLoopBackOff"}}}
+			]}}]
+		}`,
+	})
+
+	err := ClassifyRolloutFailure(run, "dagster", "app=foo", cause)
+
+	var retryable *Retryable
+	if errors.As(err, &retryable) {
+		t.Fatal("ClassifyRolloutFailure() returned *Retryable for a non-transient reason")
+	}
+	if err != cause {
+		t.Errorf("ClassifyRolloutFailure() = %v, want the original cause unchanged", err)
+	}
+}
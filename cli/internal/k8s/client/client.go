@@ -0,0 +1,167 @@
+// Package client builds Kubernetes API clients from the user's kubeconfig
+// and applies dap's own objects (namespace, secret, ConfigMap, PVC) against
+// them. It replaces the kubectl shell-outs upCmd used to issue, so a failure
+// surfaces as a typed Kubernetes API error instead of parsed CLI output, and
+// so dap no longer requires kubectl on PATH to deploy.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RestConfig loads the user's kubeconfig (respecting $KUBECONFIG and the
+// usual ~/.kube/config fallback) with its current context overridden to
+// kubeContext, the same override `kubectl config use-context` applied
+// before dap talked to the API server directly.
+func RestConfig(kubeContext string) (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+}
+
+// ServerVersion reports the API server's version string. It replaces the
+// `kubectl cluster-info` reachability check with a real discovery call, so a
+// stale or unreachable context fails with the API server's own error instead
+// of kubectl's text output.
+func ServerVersion(cfg *rest.Config) (string, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return "", err
+	}
+	v, err := dc.ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// Clientset builds a typed Kubernetes client from cfg.
+func Clientset(cfg *rest.Config) (*kubernetes.Clientset, error) {
+	return kubernetes.NewForConfig(cfg)
+}
+
+// EnsureNamespace creates namespace, leaving an existing one untouched.
+func EnsureNamespace(ctx context.Context, cs *kubernetes.Clientset, namespace string) error {
+	_, err := cs.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// EnsureSecret creates a generic secret named name holding a freshly
+// generated password under key, if one doesn't already exist in namespace.
+// It reports whether it created a new secret.
+func EnsureSecret(ctx context.Context, cs *kubernetes.Clientset, namespace, name, key string) (created bool, err error) {
+	_, err = cs.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	password, err := randomPassword()
+	if err != nil {
+		return false, fmt.Errorf("generating password: %w", err)
+	}
+
+	_, err = cs.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		StringData: map[string]string{key: password},
+	}, metav1.CreateOptions{})
+	return err == nil, err
+}
+
+// randomPassword returns a base64-encoded 24-byte random value, the same
+// shape `openssl rand -base64 24` produced.
+func randomPassword() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf), nil
+}
+
+// ApplyPVC decodes the PersistentVolumeClaim manifest at path and creates it
+// in namespace, leaving an existing claim with the same name untouched
+// since PVCs are immutable once bound.
+func ApplyPVC(ctx context.Context, cs *kubernetes.Clientset, namespace, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	raw := map[string]interface{}{}
+	if err := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096).Decode(&raw); err != nil {
+		return fmt.Errorf("decoding %s: %w", path, err)
+	}
+	obj := &unstructured.Unstructured{Object: raw}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pvc); err != nil {
+		return fmt.Errorf("converting %s: %w", path, err)
+	}
+	pvc.Namespace = namespace
+
+	_, err = cs.CoreV1().PersistentVolumeClaims(namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// ApplyConfigMapFromDir creates or updates a ConfigMap named name in
+// namespace with one data key per file directly under dir, mirroring
+// `kubectl create configmap --from-file=dir | kubectl apply -f -`.
+func ApplyConfigMapFromDir(ctx context.Context, cs *kubernetes.Clientset, namespace, name, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		data[entry.Name()] = string(content)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       data,
+	}
+
+	if _, err := cs.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		_, err = cs.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	}
+	return nil
+}
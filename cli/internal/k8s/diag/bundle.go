@@ -0,0 +1,48 @@
+package diag
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// WriteBundle archives files into a gzipped tarball under dir, named
+// dap-diag-<timestamp>.tar.gz, and returns its path.
+func WriteBundle(dir string, files []File, now time.Time) (string, error) {
+	path := filepath.Join(dir, fmt.Sprintf("dap-diag-%s.tar.gz", now.Format("20060102-150405")))
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("creating bundle file: %w", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	for _, file := range files {
+		hdr := &tar.Header{
+			Name: file.Name,
+			Mode: 0o644,
+			Size: int64(len(file.Content)),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return "", fmt.Errorf("writing header for %s: %w", file.Name, err)
+		}
+		if _, err := tw.Write(file.Content); err != nil {
+			return "", fmt.Errorf("writing content for %s: %w", file.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return "", fmt.Errorf("closing tar writer: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("closing gzip writer: %w", err)
+	}
+
+	return path, nil
+}
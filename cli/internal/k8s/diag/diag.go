@@ -0,0 +1,163 @@
+// Package diag collects Kubernetes diagnostics bundles for "dap k8s diag".
+//
+// Collection is split into small per-resource collectors that each take a
+// Runner so tests can stub kubectl/helm invocations without a real cluster.
+package diag
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Runner executes a command and returns its stdout, matching the signature
+// of exec.Run so the real CLI and tests can share collectors.
+type Runner func(name string, args ...string) (string, error)
+
+// Options configures a diagnostics bundle collection.
+type Options struct {
+	Namespace string
+	Release   string
+	Tail      int
+	Redact    bool
+}
+
+// File is a single entry collected into the diagnostics bundle.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// resourceKinds are gathered with one `kubectl get <kind> -n <ns> -o yaml` per kind.
+var resourceKinds = []string{"pods", "jobs", "pvc", "configmap", "events"}
+
+// podSelectors identifies which pods get a `describe` and container logs collected.
+var podSelectors = []string{
+	"app.kubernetes.io/name=dagster-user-deployments",
+	"app.kubernetes.io/component=dagster-webserver",
+	"app.kubernetes.io/component=dagster-daemon",
+}
+
+// Collect gathers all diagnostic resources for opts, invoking kubectl/helm via run.
+func Collect(run Runner, opts Options) ([]File, error) {
+	var files []File
+
+	files = append(files, collectResources(run, opts)...)
+	files = append(files, collectHelm(run, opts)...)
+
+	podFiles, err := collectPods(run, opts)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, podFiles...)
+
+	if opts.Redact {
+		for i := range files {
+			files[i].Content = Redact(files[i].Content)
+		}
+	}
+
+	return files, nil
+}
+
+func collectResources(run Runner, opts Options) []File {
+	files := make([]File, 0, len(resourceKinds))
+	for _, kind := range resourceKinds {
+		out, err := run("kubectl", "get", kind, "-n", opts.Namespace, "-o", "yaml")
+		if err != nil {
+			out = fmt.Sprintf("error collecting %s: %v", kind, err)
+		}
+		files = append(files, File{Name: kind + ".yaml", Content: []byte(out)})
+	}
+	return files
+}
+
+func collectHelm(run Runner, opts Options) []File {
+	specs := []struct {
+		name string
+		args []string
+	}{
+		{"helm-status.txt", []string{"status", opts.Release, "-n", opts.Namespace}},
+		{"helm-values.yaml", []string{"get", "values", opts.Release, "-n", opts.Namespace}},
+		{"helm-manifest.yaml", []string{"get", "manifest", opts.Release, "-n", opts.Namespace}},
+	}
+
+	files := make([]File, 0, len(specs))
+	for _, s := range specs {
+		out, err := run("helm", s.args...)
+		if err != nil {
+			out = fmt.Sprintf("error collecting %s: %v", s.name, err)
+		}
+		files = append(files, File{Name: s.name, Content: []byte(out)})
+	}
+	return files
+}
+
+// podList is the subset of `kubectl get pods -o json` this package cares about.
+type podList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Containers []struct {
+				Name string `json:"name"`
+			} `json:"containers"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+func collectPods(run Runner, opts Options) ([]File, error) {
+	var files []File
+	seen := map[string]bool{}
+
+	for _, selector := range podSelectors {
+		out, err := run("kubectl", "get", "pods", "-n", opts.Namespace, "-l", selector, "-o", "json")
+		if err != nil {
+			// A selector matching nothing (e.g. no daemon pod yet) isn't fatal.
+			continue
+		}
+
+		var list podList
+		if err := json.Unmarshal([]byte(out), &list); err != nil {
+			return nil, fmt.Errorf("parsing pod list for selector %q: %w", selector, err)
+		}
+
+		for _, pod := range list.Items {
+			if seen[pod.Metadata.Name] {
+				continue
+			}
+			seen[pod.Metadata.Name] = true
+			files = append(files, collectPod(run, opts, pod.Metadata.Name, pod.Spec.Containers)...)
+		}
+	}
+
+	return files, nil
+}
+
+func collectPod(run Runner, opts Options, podName string, containers []struct {
+	Name string `json:"name"`
+}) []File {
+	var files []File
+
+	describe, err := run("kubectl", "describe", "pod", podName, "-n", opts.Namespace)
+	if err != nil {
+		describe = fmt.Sprintf("error describing pod %s: %v", podName, err)
+	}
+	files = append(files, File{Name: "describe-" + podName + ".txt", Content: []byte(describe)})
+
+	tail := fmt.Sprintf("%d", opts.Tail)
+	for _, c := range containers {
+		logs, err := run("kubectl", "logs", podName, "-c", c.Name, "-n", opts.Namespace, "--tail", tail)
+		if err != nil {
+			logs = fmt.Sprintf("error collecting logs for %s/%s: %v", podName, c.Name, err)
+		}
+		files = append(files, File{Name: fmt.Sprintf("logs-%s-%s.txt", podName, c.Name), Content: []byte(logs)})
+
+		// The previous container instance may not exist (no restart yet); that's not an error.
+		if prevLogs, err := run("kubectl", "logs", podName, "-c", c.Name, "-n", opts.Namespace, "--previous", "--tail", tail); err == nil {
+			files = append(files, File{Name: fmt.Sprintf("logs-%s-%s-previous.txt", podName, c.Name), Content: []byte(prevLogs)})
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,25 @@
+package diag
+
+import "regexp"
+
+const redactedPlaceholder = "***REDACTED***"
+
+// secretPatterns matches values that must never leave the cluster in a shared
+// diagnostics bundle: the dagster-postgresql secret's password and anything
+// that looks like a database URL or bearer token.
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(postgresql-password:\s*)\S+`),
+	regexp.MustCompile(`(?i)(DATABASE_URL["']?\s*[:=]\s*)\S+`),
+	regexp.MustCompile(`(?i)(token["']?\s*[:=]\s*)\S+`),
+	regexp.MustCompile(`(?i)(bearer\s+)\S+`),
+	regexp.MustCompile(`(?i)(password["']?\s*[:=]\s*)\S+`),
+}
+
+// Redact scrubs secret-looking values from content before it is archived.
+func Redact(content []byte) []byte {
+	out := content
+	for _, pattern := range secretPatterns {
+		out = pattern.ReplaceAll(out, []byte("${1}"+redactedPlaceholder))
+	}
+	return out
+}
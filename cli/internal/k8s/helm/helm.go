@@ -0,0 +1,150 @@
+// Package helm wraps the Helm Go SDK's action package so dap's up command
+// can deploy the Dagster chart without shelling out to the helm binary,
+// getting a typed release.Release back instead of upgrade output on stdout.
+package helm
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// Config describes a single `helm upgrade --install` equivalent.
+type Config struct {
+	ReleaseName string
+	Namespace   string
+	Chart       string
+	Version     string
+	KubeContext string
+	// ValueFiles are merged in order, later files overriding earlier ones.
+	// A missing file is skipped rather than treated as an error, since
+	// values-local.yaml is optional.
+	ValueFiles []string
+}
+
+// newActionConfig builds the Helm SDK's action.Configuration for namespace,
+// the object every action.New* constructor needs. It's the one place that
+// reads the user's kubeconfig, so every k8s subcommand backed by Helm shares
+// the same context/namespace resolution and storage driver.
+func newActionConfig(namespace, kubeContext string) (*cli.EnvSettings, *action.Configuration, error) {
+	settings := cli.New()
+	settings.SetNamespace(namespace)
+	if kubeContext != "" {
+		settings.KubeContext = kubeContext
+	}
+
+	actionCfg := new(action.Configuration)
+	if err := actionCfg.Init(settings.RESTClientGetter(), namespace, os.Getenv("HELM_DRIVER"), func(string, ...interface{}) {}); err != nil {
+		return nil, nil, fmt.Errorf("initializing helm: %w", err)
+	}
+	return settings, actionCfg, nil
+}
+
+// UpgradeInstall installs cfg.Chart as cfg.ReleaseName if it does not already
+// exist in cfg.Namespace, or upgrades it in place otherwise.
+func UpgradeInstall(cfg Config) (*release.Release, error) {
+	settings, actionCfg, err := newActionConfig(cfg.Namespace, cfg.KubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	client := action.NewUpgrade(actionCfg)
+	client.Install = true
+	client.Namespace = cfg.Namespace
+	client.Version = cfg.Version
+	client.SkipSchemaValidation = true
+
+	chartPath, err := client.ChartPathOptions.LocateChart(cfg.Chart, settings)
+	if err != nil {
+		return nil, fmt.Errorf("locating chart %s: %w", cfg.Chart, err)
+	}
+	chrt, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading chart: %w", err)
+	}
+
+	vals, err := mergeValueFiles(cfg.ValueFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Run(cfg.ReleaseName, chrt, vals)
+}
+
+// mergeValueFiles reads each path in order and merges them into a single
+// values tree, later paths overriding earlier ones, the same precedence
+// `helm -f a.yaml -f b.yaml` applies.
+func mergeValueFiles(paths []string) (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		var vals map[string]interface{}
+		if err := yaml.Unmarshal(data, &vals); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		merged = chartutil.CoalesceTables(vals, merged)
+	}
+	return merged, nil
+}
+
+// ReleaseStatus returns the current state of releaseName in namespace,
+// including its chart version and deployed values, replacing `helm status`.
+func ReleaseStatus(releaseName, namespace, kubeContext string) (*release.Release, error) {
+	_, actionCfg, err := newActionConfig(namespace, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return action.NewStatus(actionCfg).Run(releaseName)
+}
+
+// Uninstall removes releaseName from namespace. keepHistory mirrors `helm
+// uninstall --keep-history`; dap's own callers pass false so a subsequent
+// `dap k8s up` starts from a clean revision history instead of resuming one
+// Helm considers uninstalled.
+func Uninstall(releaseName, namespace, kubeContext string, keepHistory, dryRun bool) (*release.UninstallReleaseResponse, error) {
+	_, actionCfg, err := newActionConfig(namespace, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	client := action.NewUninstall(actionCfg)
+	client.KeepHistory = keepHistory
+	client.DryRun = dryRun
+	return client.Run(releaseName)
+}
+
+// History lists every revision recorded for releaseName, oldest first, the
+// same order `helm history` prints.
+func History(releaseName, namespace, kubeContext string) ([]*release.Release, error) {
+	_, actionCfg, err := newActionConfig(namespace, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return action.NewHistory(actionCfg).Run(releaseName)
+}
+
+// Rollback reverts releaseName to revision, or to the previous revision if
+// revision is 0, matching `helm rollback` called without an explicit
+// revision argument.
+func Rollback(releaseName, namespace, kubeContext string, revision int, dryRun bool) error {
+	_, actionCfg, err := newActionConfig(namespace, kubeContext)
+	if err != nil {
+		return err
+	}
+	client := action.NewRollback(actionCfg)
+	client.Version = revision
+	client.DryRun = dryRun
+	return client.Run(releaseName)
+}
@@ -0,0 +1,35 @@
+// Package k8s holds the Retryable error type and the rollout-failure
+// classifier that produces it, for the k8s commands that drive them.
+package k8s
+
+import "time"
+
+// Retryable marks an error as transient: the caller should wait After and
+// try again rather than surfacing a terminal failure. It's returned by
+// classifiers that inspect kubectl/helm output for conditions like
+// ImagePullBackOff-still-pulling, ContainerCreating, or connection refused,
+// which normally resolve on their own on a dev cluster.
+type Retryable struct {
+	// After is how long to wait before retrying.
+	After time.Duration
+	// Reason is a short, user-facing description of the transient condition.
+	Reason string
+	// Cause is the underlying error, if any.
+	Cause error
+}
+
+func (e *Retryable) Error() string {
+	if e.Cause != nil {
+		return e.Reason + ": " + e.Cause.Error()
+	}
+	return e.Reason
+}
+
+func (e *Retryable) Unwrap() error {
+	return e.Cause
+}
+
+// RetryAfter implements retry.Retryable.
+func (e *Retryable) RetryAfter() time.Duration {
+	return e.After
+}
@@ -0,0 +1,168 @@
+// Package wait polls Dagster's Kubernetes resources until they report ready,
+// returning structured events instead of raw API responses so the same
+// logic can drive a CLI status line or future automation.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PodStatus is a structured snapshot of a single pod's readiness.
+type PodStatus struct {
+	Name         string
+	Phase        string
+	Ready        bool
+	RestartCount int32
+	// WaitingReason is the first waiting container's reason (e.g.
+	// ContainerCreating, ImagePullBackOff, CrashLoopBackOff), empty once
+	// no container is waiting.
+	WaitingReason string
+}
+
+// Event reports one polling round.
+type Event struct {
+	Pods    []PodStatus
+	Elapsed time.Duration
+}
+
+// Options configures Wait.
+type Options struct {
+	// Namespace is the Kubernetes namespace to poll.
+	Namespace string
+	// PodSelector selects the pods whose readiness is being waited on.
+	PodSelector string
+	// Deployment, if set, must also report every replica available. Leave
+	// empty to wait on pods only.
+	Deployment string
+	// Timeout is the maximum time to wait before giving up.
+	Timeout time.Duration
+	// Interval between polls. Defaults to 2s.
+	Interval time.Duration
+	// OnEvent, if set, is called once per poll with the current state.
+	OnEvent func(Event)
+}
+
+const defaultInterval = 2 * time.Second
+
+// Wait polls opts.PodSelector (and opts.Deployment, if set) in opts.Namespace
+// until every pod reports PodReady=True and the deployment has
+// availableReplicas >= replicas, or opts.Timeout elapses.
+func Wait(ctx context.Context, cs kubernetes.Interface, opts Options) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	start := time.Now()
+	deadline := start.Add(opts.Timeout)
+
+	for {
+		pods, err := podStatuses(ctx, cs, opts.Namespace, opts.PodSelector)
+		if err != nil {
+			return fmt.Errorf("listing pods: %w", err)
+		}
+
+		deploymentReady, err := isDeploymentReady(ctx, cs, opts.Namespace, opts.Deployment)
+		if err != nil {
+			return fmt.Errorf("checking deployment %s: %w", opts.Deployment, err)
+		}
+
+		if opts.OnEvent != nil {
+			opts.OnEvent(Event{Pods: pods, Elapsed: time.Since(start)})
+		}
+
+		if deploymentReady && allPodsReady(pods) {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for %s to become ready: %s",
+				opts.Timeout, opts.PodSelector, summarizeNotReady(pods))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func allPodsReady(pods []PodStatus) bool {
+	if len(pods) == 0 {
+		return false
+	}
+	for _, p := range pods {
+		if !p.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// summarizeNotReady describes every pod still not ready, for a timeout
+// error's message, e.g. "ucd-0 (Pending), ucd-1 (CrashLoopBackOff)".
+func summarizeNotReady(pods []PodStatus) string {
+	var notReady []string
+	for _, p := range pods {
+		if p.Ready {
+			continue
+		}
+		reason := p.WaitingReason
+		if reason == "" {
+			reason = p.Phase
+		}
+		notReady = append(notReady, fmt.Sprintf("%s (%s)", p.Name, reason))
+	}
+	if len(notReady) == 0 {
+		return "no matching pods found"
+	}
+	return strings.Join(notReady, ", ")
+}
+
+func podStatuses(ctx context.Context, cs kubernetes.Interface, namespace, selector string) ([]PodStatus, error) {
+	list, err := cs.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]PodStatus, 0, len(list.Items))
+	for _, pod := range list.Items {
+		ps := PodStatus{Name: pod.Name, Phase: string(pod.Status.Phase)}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == "Ready" {
+				ps.Ready = c.Status == "True"
+			}
+		}
+		for _, cstatus := range pod.Status.ContainerStatuses {
+			ps.RestartCount += cstatus.RestartCount
+			if cstatus.State.Waiting != nil && ps.WaitingReason == "" {
+				ps.WaitingReason = cstatus.State.Waiting.Reason
+			}
+		}
+		statuses = append(statuses, ps)
+	}
+	return statuses, nil
+}
+
+// isDeploymentReady reports whether name has every replica available. An
+// empty name means no deployment is being waited on, so it's trivially
+// ready.
+func isDeploymentReady(ctx context.Context, cs kubernetes.Interface, namespace, name string) (bool, error) {
+	if name == "" {
+		return true, nil
+	}
+
+	dep, err := cs.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return dep.Status.Replicas > 0 && dep.Status.AvailableReplicas >= dep.Status.Replicas, nil
+}
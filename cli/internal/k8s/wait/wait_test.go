@@ -0,0 +1,137 @@
+package wait
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func readyPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Labels:    map[string]string{"app.kubernetes.io/name": "dagster-user-deployments"},
+			Namespace: "dagster",
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func pendingPod(name, waitingReason string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Labels:    map[string]string{"app.kubernetes.io/name": "dagster-user-deployments"},
+			Namespace: "dagster",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason}},
+			}},
+		},
+	}
+}
+
+func readyDeployment(name string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "dagster"},
+		Status:     appsv1.DeploymentStatus{Replicas: replicas, AvailableReplicas: replicas},
+	}
+}
+
+const podSelector = "app.kubernetes.io/name=dagster-user-deployments"
+
+func TestWaitSucceedsWhenPodsAndDeploymentReady(t *testing.T) {
+	cs := fake.NewSimpleClientset(readyPod("ucd-0"), readyDeployment("dagster-webserver", 1))
+
+	var events []Event
+	err := Wait(context.Background(), cs, Options{
+		Namespace:   "dagster",
+		PodSelector: podSelector,
+		Deployment:  "dagster-webserver",
+		Timeout:     time.Second,
+		Interval:    time.Millisecond,
+		OnEvent:     func(e Event) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("OnEvent called %d times, want 1", len(events))
+	}
+	if !events[0].Pods[0].Ready {
+		t.Error("pod should be reported ready")
+	}
+}
+
+func TestWaitTimesOutWhenPodNotReady(t *testing.T) {
+	cs := fake.NewSimpleClientset(pendingPod("ucd-0", "ContainerCreating"))
+
+	err := Wait(context.Background(), cs, Options{
+		Namespace:   "dagster",
+		PodSelector: podSelector,
+		Timeout:     5 * time.Millisecond,
+		Interval:    time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("Wait() expected timeout error, got nil")
+	}
+	if got := err.Error(); !strings.Contains(got, "ContainerCreating") {
+		t.Errorf("Wait() error = %q, want it to mention the waiting reason", got)
+	}
+}
+
+func TestWaitTimesOutWhenNoPodsFound(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+
+	err := Wait(context.Background(), cs, Options{
+		Namespace:   "dagster",
+		PodSelector: podSelector,
+		Timeout:     5 * time.Millisecond,
+		Interval:    time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("Wait() expected timeout error when no pods are scheduled, got nil")
+	}
+}
+
+func TestWaitWithoutDeploymentIgnoresRollout(t *testing.T) {
+	cs := fake.NewSimpleClientset(readyPod("ucd-0"))
+
+	err := Wait(context.Background(), cs, Options{
+		Namespace:   "dagster",
+		PodSelector: podSelector,
+		Timeout:     time.Second,
+		Interval:    time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Wait() error = %v, want nil when no Deployment is set", err)
+	}
+}
+
+func TestWaitRespectsContextCancellation(t *testing.T) {
+	cs := fake.NewSimpleClientset(pendingPod("ucd-0", "ContainerCreating"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Wait(ctx, cs, Options{
+		Namespace:   "dagster",
+		PodSelector: podSelector,
+		Timeout:     time.Second,
+		Interval:    time.Second,
+	})
+	if err != context.Canceled {
+		t.Fatalf("Wait() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,112 @@
+// Package manifest walks a cobra command tree and reduces it to a
+// JSON-serializable description of every command, flag, and group, so the
+// result can be committed as cli-manifest.json and diffed in CI to catch an
+// accidental flag removal or rename before it reaches users.
+package manifest
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Manifest is the root of a generated command tree description.
+type Manifest struct {
+	Command Command `json:"command"`
+}
+
+// Command describes a single cobra.Command: everything a caller would need
+// to notice if its contract changed - its Use/Short/Long text, the flags and
+// groups it belongs to, and its children.
+type Command struct {
+	Use                   string    `json:"use"`
+	Short                 string    `json:"short,omitempty"`
+	Long                  string    `json:"long,omitempty"`
+	GroupID               string    `json:"groupId,omitempty"`
+	Hidden                bool      `json:"hidden,omitempty"`
+	DisableFlagParsing    bool      `json:"disableFlagParsing,omitempty"`
+	DisableFlagsInUseLine bool      `json:"disableFlagsInUseLine,omitempty"`
+	ValidArgs             []string  `json:"validArgs,omitempty"`
+	ArgsValidator         string    `json:"argsValidator,omitempty"`
+	Flags                 []Flag    `json:"flags,omitempty"`
+	Commands              []Command `json:"commands,omitempty"`
+}
+
+// Flag describes a single pflag.Flag declared directly on a command (not one
+// inherited from a parent's persistent flags - those already show up on the
+// parent's own entry).
+type Flag struct {
+	Name       string `json:"name"`
+	Shorthand  string `json:"shorthand,omitempty"`
+	Type       string `json:"type"`
+	Default    string `json:"default"`
+	Usage      string `json:"usage,omitempty"`
+	Persistent bool   `json:"persistent,omitempty"`
+}
+
+// Build walks root and every descendant command, reducing the tree to a
+// Manifest. Cobra's own auto-registered "help" command is skipped, since it's
+// not something this codebase authored and its presence/absence says
+// nothing about dap's own contract.
+func Build(root *cobra.Command) Manifest {
+	return Manifest{Command: buildCommand(root)}
+}
+
+func buildCommand(cmd *cobra.Command) Command {
+	c := Command{
+		Use:                   cmd.Use,
+		Short:                 cmd.Short,
+		Long:                  cmd.Long,
+		GroupID:               cmd.GroupID,
+		Hidden:                cmd.Hidden,
+		DisableFlagParsing:    cmd.DisableFlagParsing,
+		DisableFlagsInUseLine: cmd.DisableFlagsInUseLine,
+		ValidArgs:             cmd.ValidArgs,
+		ArgsValidator:         argsValidatorName(cmd),
+	}
+
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Name == "help" {
+			return
+		}
+		c.Flags = append(c.Flags, Flag{
+			Name:       f.Name,
+			Shorthand:  f.Shorthand,
+			Type:       f.Value.Type(),
+			Default:    f.DefValue,
+			Usage:      f.Usage,
+			Persistent: cmd.PersistentFlags().Lookup(f.Name) != nil,
+		})
+	})
+	sort.Slice(c.Flags, func(i, j int) bool { return c.Flags[i].Name < c.Flags[j].Name })
+
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == "help" {
+			continue
+		}
+		c.Commands = append(c.Commands, buildCommand(sub))
+	}
+	sort.Slice(c.Commands, func(i, j int) bool { return c.Commands[i].Use < c.Commands[j].Use })
+
+	return c
+}
+
+// argsValidatorName returns the name of the function backing cmd.Args, e.g.
+// "github.com/spf13/cobra.ArbitraryArgs", or "" if no validator is set. This
+// is best-effort: cobra's parameterized validators (ExactArgs(n), ...) are
+// closures that all share one underlying function regardless of n, so the
+// name alone doesn't capture the count - it's enough to see *that* a
+// command's argument handling changed shape, not to reconstruct it exactly.
+func argsValidatorName(cmd *cobra.Command) string {
+	if cmd.Args == nil {
+		return ""
+	}
+	fn := runtime.FuncForPC(reflect.ValueOf(cmd.Args).Pointer())
+	if fn == nil {
+		return ""
+	}
+	return fn.Name()
+}
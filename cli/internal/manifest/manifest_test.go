@@ -0,0 +1,103 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestBuildFlagsAndChildren(t *testing.T) {
+	child := &cobra.Command{Use: "child", Short: "a child command", GroupID: "grp"}
+	child.Flags().Bool("verbose", false, "be verbose")
+	child.Flags().StringP("name", "n", "default-name", "a name")
+
+	root := &cobra.Command{Use: "root", Short: "a root command"}
+	root.PersistentFlags().Bool("quiet", false, "be quiet")
+	root.AddCommand(child)
+
+	m := Build(root)
+
+	if m.Command.Use != "root" {
+		t.Errorf("Command.Use = %q, want %q", m.Command.Use, "root")
+	}
+	if len(m.Command.Flags) != 1 || m.Command.Flags[0].Name != "quiet" {
+		t.Fatalf("Command.Flags = %+v, want one flag named quiet", m.Command.Flags)
+	}
+	if !m.Command.Flags[0].Persistent {
+		t.Error("root's quiet flag should be marked persistent")
+	}
+
+	if len(m.Command.Commands) != 1 {
+		t.Fatalf("len(Command.Commands) = %d, want 1", len(m.Command.Commands))
+	}
+	gotChild := m.Command.Commands[0]
+	if gotChild.Use != "child" || gotChild.GroupID != "grp" {
+		t.Errorf("child command = %+v, want Use=child GroupID=grp", gotChild)
+	}
+	if len(gotChild.Flags) != 2 {
+		t.Fatalf("len(child.Flags) = %d, want 2", len(gotChild.Flags))
+	}
+	// Flags are sorted by name: "name" before "verbose".
+	if gotChild.Flags[0].Name != "name" || gotChild.Flags[0].Shorthand != "n" || gotChild.Flags[0].Default != "default-name" {
+		t.Errorf("child.Flags[0] = %+v, want name flag with shorthand n", gotChild.Flags[0])
+	}
+	if gotChild.Flags[1].Name != "verbose" || gotChild.Flags[1].Type != "bool" {
+		t.Errorf("child.Flags[1] = %+v, want verbose bool flag", gotChild.Flags[1])
+	}
+}
+
+func TestBuildSkipsAutoHelpCommand(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	root.AddCommand(&cobra.Command{Use: "real"})
+	root.InitDefaultHelpCmd()
+
+	m := Build(root)
+	for _, c := range m.Command.Commands {
+		if c.Use == "help [command]" {
+			t.Error("Build() included cobra's auto-registered help command")
+		}
+	}
+	if len(m.Command.Commands) != 1 {
+		t.Errorf("len(Command.Commands) = %d, want 1 (only \"real\")", len(m.Command.Commands))
+	}
+}
+
+func TestBuildSkipsAutoHelpFlag(t *testing.T) {
+	root := &cobra.Command{Use: "root", Run: func(cmd *cobra.Command, args []string) {}}
+	root.InitDefaultHelpFlag()
+
+	m := Build(root)
+	for _, f := range m.Command.Flags {
+		if f.Name == "help" {
+			t.Error("Build() included cobra's auto-registered help flag")
+		}
+	}
+}
+
+func TestArgsValidatorName(t *testing.T) {
+	withValidator := &cobra.Command{Use: "a", Args: cobra.ArbitraryArgs}
+	m := Build(withValidator)
+	if m.Command.ArgsValidator == "" {
+		t.Error("ArgsValidator is empty, want a function name for cobra.ArbitraryArgs")
+	}
+
+	withoutValidator := &cobra.Command{Use: "b"}
+	m = Build(withoutValidator)
+	if m.Command.ArgsValidator != "" {
+		t.Errorf("ArgsValidator = %q, want empty when Args is nil", m.Command.ArgsValidator)
+	}
+}
+
+func TestSchemaIsValidJSONShape(t *testing.T) {
+	s := Schema()
+	if s["$schema"] == "" {
+		t.Error("Schema()[\"$schema\"] is empty")
+	}
+	defs, ok := s["$defs"].(map[string]any)
+	if !ok {
+		t.Fatal("Schema()[\"$defs\"] is not a map")
+	}
+	if _, ok := defs["command"]; !ok {
+		t.Error("Schema()[\"$defs\"] missing \"command\"")
+	}
+}
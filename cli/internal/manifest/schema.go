@@ -0,0 +1,63 @@
+package manifest
+
+// Schema returns a JSON Schema (draft 2020-12) describing Manifest's shape,
+// for tooling that wants to validate cli-manifest.json without depending on
+// Go's encoding/json or this package directly. It's maintained by hand
+// alongside Manifest/Command/Flag rather than generated by reflection, since
+// the three types change rarely and a hand-written schema is easier to read
+// than one assembled field-by-field at runtime.
+func Schema() map[string]any {
+	command := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []string{"use"},
+		"properties": map[string]any{
+			"use":                   map[string]any{"type": "string"},
+			"short":                 map[string]any{"type": "string"},
+			"long":                  map[string]any{"type": "string"},
+			"groupId":               map[string]any{"type": "string"},
+			"hidden":                map[string]any{"type": "boolean"},
+			"disableFlagParsing":    map[string]any{"type": "boolean"},
+			"disableFlagsInUseLine": map[string]any{"type": "boolean"},
+			"validArgs": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "string"},
+			},
+			"argsValidator": map[string]any{"type": "string"},
+			"flags": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"name", "type", "default"},
+					"properties": map[string]any{
+						"name":       map[string]any{"type": "string"},
+						"shorthand":  map[string]any{"type": "string"},
+						"type":       map[string]any{"type": "string"},
+						"default":    map[string]any{"type": "string"},
+						"usage":      map[string]any{"type": "string"},
+						"persistent": map[string]any{"type": "boolean"},
+					},
+				},
+			},
+			"commands": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"$ref": "#/$defs/command"},
+			},
+		},
+	}
+
+	return map[string]any{
+		"$schema":  "https://json-schema.org/draft/2020-12/schema",
+		"$id":      "https://github.com/eth-library/dap/cli-manifest.schema.json",
+		"title":    "dap CLI command manifest",
+		"type":     "object",
+		"required": []string{"command"},
+		"properties": map[string]any{
+			"command": map[string]any{"$ref": "#/$defs/command"},
+		},
+		"$defs": map[string]any{
+			"command": command,
+		},
+	}
+}
@@ -0,0 +1,180 @@
+// Package report parses machine-readable test output (JUnit XML and
+// pytest-json-report JSON) into a common Summary, and aggregates the
+// lint/typecheck/test outcomes of a `dap check` run into a single
+// QualityReport artifact for CI to consume.
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Summary is a test run reduced to pass/fail/skip counts and a duration, as
+// reported by either JUnit XML or pytest-json-report.
+type Summary struct {
+	Passed   int
+	Failed   int
+	Skipped  int
+	Total    int
+	Duration time.Duration
+}
+
+// String renders s as a one-line summary, e.g. "12 passed, 1 failed, 2
+// skipped in 3.45s".
+func (s Summary) String() string {
+	return fmt.Sprintf("%d passed, %d failed, %d skipped in %.2fs", s.Passed, s.Failed, s.Skipped, s.Duration.Seconds())
+}
+
+// summaryJSON is Summary's wire representation: Duration is reported in
+// seconds rather than Go's default nanosecond encoding of time.Duration, to
+// match StepOutcome.Seconds and keep summary.json readable.
+type summaryJSON struct {
+	Passed   int     `json:"passed"`
+	Failed   int     `json:"failed"`
+	Skipped  int     `json:"skipped"`
+	Total    int     `json:"total"`
+	Duration float64 `json:"durationSeconds"`
+}
+
+// MarshalJSON encodes s via summaryJSON.
+func (s Summary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(summaryJSON{
+		Passed:   s.Passed,
+		Failed:   s.Failed,
+		Skipped:  s.Skipped,
+		Total:    s.Total,
+		Duration: s.Duration.Seconds(),
+	})
+}
+
+// ParseJUnit reads the testsuite(s) written by `pytest --junitxml` and
+// reduces them to a Summary. It scans for <testsuite> elements token by
+// token rather than unmarshaling a fixed root, since pytest emits a bare
+// <testsuite> for a single run but wraps multiple in <testsuites> - this
+// way both shapes sum correctly.
+func ParseJUnit(path string) (Summary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Summary{}, fmt.Errorf("opening junit report: %w", err)
+	}
+	defer f.Close()
+
+	var sum Summary
+	dec := xml.NewDecoder(f)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Summary{}, fmt.Errorf("parsing junit report: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "testsuite" {
+			continue
+		}
+
+		var tests, failures, errors, skipped int
+		var seconds float64
+		for _, attr := range start.Attr {
+			switch attr.Name.Local {
+			case "tests":
+				tests, _ = strconv.Atoi(attr.Value)
+			case "failures":
+				failures, _ = strconv.Atoi(attr.Value)
+			case "errors":
+				errors, _ = strconv.Atoi(attr.Value)
+			case "skipped":
+				skipped, _ = strconv.Atoi(attr.Value)
+			case "time":
+				seconds, _ = strconv.ParseFloat(attr.Value, 64)
+			}
+		}
+
+		sum.Total += tests
+		sum.Failed += failures + errors
+		sum.Skipped += skipped
+		sum.Duration += time.Duration(seconds * float64(time.Second))
+	}
+	sum.Passed = sum.Total - sum.Failed - sum.Skipped
+
+	return sum, nil
+}
+
+// jsonReport mirrors the subset of pytest-json-report's schema Summary
+// needs; the plugin emits a lot more (collected test IDs, warnings, ...)
+// that dap has no use for. Duration is a top-level field, not part of
+// "summary" (which holds only counts).
+type jsonReport struct {
+	Duration float64 `json:"duration"`
+	Summary  struct {
+		Passed  int `json:"passed"`
+		Failed  int `json:"failed"`
+		Error   int `json:"error"`
+		Skipped int `json:"skipped"`
+		Total   int `json:"total"`
+	} `json:"summary"`
+}
+
+// ParseJSON reads the report written by `pytest --json-report` and reduces
+// it to a Summary. "error" outcomes (failures during setup/teardown rather
+// than the test itself) are folded into Failed, matching ParseJUnit's
+// failures+errors handling.
+func ParseJSON(path string) (Summary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Summary{}, fmt.Errorf("reading json report: %w", err)
+	}
+
+	var r jsonReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		return Summary{}, fmt.Errorf("parsing json report: %w", err)
+	}
+
+	return Summary{
+		Passed:   r.Summary.Passed,
+		Failed:   r.Summary.Failed + r.Summary.Error,
+		Skipped:  r.Summary.Skipped,
+		Total:    r.Summary.Total,
+		Duration: time.Duration(r.Duration * float64(time.Second)),
+	}, nil
+}
+
+// StepOutcome is one quality-check step's result within a QualityReport.
+// Summary is only populated for the test step; lint and typecheck only
+// have a pass/fail outcome and a timing.
+type StepOutcome struct {
+	Passed  bool     `json:"passed"`
+	Seconds float64  `json:"seconds"`
+	Summary *Summary `json:"summary,omitempty"`
+}
+
+// QualityReport aggregates the outcome of `dap check`'s steps into a single
+// machine-readable artifact, written as summary.json under --report-dir.
+type QualityReport struct {
+	Lint      *StepOutcome `json:"lint,omitempty"`
+	Typecheck *StepOutcome `json:"typecheck,omitempty"`
+	Test      *StepOutcome `json:"test,omitempty"`
+}
+
+// WriteSummaryJSON writes r as "summary.json" inside dir.
+func WriteSummaryJSON(dir string, r QualityReport) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding quality report: %w", err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join(dir, "summary.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing quality report: %w", err)
+	}
+	return nil
+}
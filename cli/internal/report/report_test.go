@@ -0,0 +1,103 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSummaryString(t *testing.T) {
+	s := Summary{Passed: 12, Failed: 1, Skipped: 2, Total: 15, Duration: 3450 * time.Millisecond}
+	want := "12 passed, 1 failed, 2 skipped in 3.45s"
+	if got := s.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestParseJUnitSingleSuite(t *testing.T) {
+	path := writeFile(t, "report.xml", `<?xml version="1.0"?>
+<testsuite name="pytest" tests="10" failures="1" errors="0" skipped="2" time="1.5">
+</testsuite>`)
+
+	got, err := ParseJUnit(path)
+	if err != nil {
+		t.Fatalf("ParseJUnit() error = %v", err)
+	}
+	want := Summary{Passed: 7, Failed: 1, Skipped: 2, Total: 10, Duration: 1500 * time.Millisecond}
+	if got != want {
+		t.Errorf("ParseJUnit() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseJUnitWrappedSuites(t *testing.T) {
+	path := writeFile(t, "report.xml", `<?xml version="1.0"?>
+<testsuites>
+  <testsuite name="unit" tests="5" failures="0" errors="1" skipped="0" time="0.5"></testsuite>
+  <testsuite name="integration" tests="3" failures="0" errors="0" skipped="1" time="2.0"></testsuite>
+</testsuites>`)
+
+	got, err := ParseJUnit(path)
+	if err != nil {
+		t.Fatalf("ParseJUnit() error = %v", err)
+	}
+	want := Summary{Passed: 6, Failed: 1, Skipped: 1, Total: 8, Duration: 2500 * time.Millisecond}
+	if got != want {
+		t.Errorf("ParseJUnit() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseJUnitMissingFile(t *testing.T) {
+	if _, err := ParseJUnit(filepath.Join(t.TempDir(), "missing.xml")); err == nil {
+		t.Error("ParseJUnit() with missing file returned nil error")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	path := writeFile(t, "report.json", `{"duration": 4.2, "summary": {"passed": 9, "failed": 2, "error": 1, "skipped": 1, "total": 13}}`)
+
+	got, err := ParseJSON(path)
+	if err != nil {
+		t.Fatalf("ParseJSON() error = %v", err)
+	}
+	want := Summary{Passed: 9, Failed: 3, Skipped: 1, Total: 13, Duration: 4200 * time.Millisecond}
+	if got != want {
+		t.Errorf("ParseJSON() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseJSONMalformed(t *testing.T) {
+	path := writeFile(t, "report.json", `not json`)
+	if _, err := ParseJSON(path); err == nil {
+		t.Error("ParseJSON() with malformed input returned nil error")
+	}
+}
+
+func TestWriteSummaryJSON(t *testing.T) {
+	dir := t.TempDir()
+	r := QualityReport{
+		Lint: &StepOutcome{Passed: true, Seconds: 0.5},
+		Test: &StepOutcome{Passed: false, Seconds: 1.2, Summary: &Summary{Passed: 1, Failed: 1, Total: 2}},
+	}
+
+	if err := WriteSummaryJSON(dir, r); err != nil {
+		t.Fatalf("WriteSummaryJSON() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "summary.json"))
+	if err != nil {
+		t.Fatalf("reading summary.json: %v", err)
+	}
+	if len(data) == 0 {
+		t.Error("summary.json is empty")
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile(%s): %v", name, err)
+	}
+	return path
+}
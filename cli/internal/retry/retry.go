@@ -0,0 +1,113 @@
+// Package retry implements capped exponential backoff with jitter for
+// operations against flaky dev clusters, where transient conditions
+// (ImagePullBackOff still pulling, ContainerCreating, connection refused)
+// routinely resolve themselves within a minute or two.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// Policy configures the backoff schedule used by Do.
+type Policy struct {
+	// InitialInterval is the delay before the second attempt. Defaults to 2s.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between attempts. Defaults to 30s.
+	MaxInterval time.Duration
+	// Multiplier scales the delay after each attempt. Defaults to 2.
+	Multiplier float64
+	// Timeout is the maximum wall-clock time Do spends retrying before
+	// giving up and returning the last error. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+const (
+	defaultInitialInterval = 2 * time.Second
+	defaultMaxInterval     = 30 * time.Second
+	defaultMultiplier      = 2.0
+	defaultTimeout         = 5 * time.Minute
+)
+
+func (p Policy) withDefaults() Policy {
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = defaultInitialInterval
+	}
+	if p.MaxInterval <= 0 {
+		p.MaxInterval = defaultMaxInterval
+	}
+	if p.Multiplier <= 0 {
+		p.Multiplier = defaultMultiplier
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = defaultTimeout
+	}
+	return p
+}
+
+// Retryable is implemented by errors that know how long to wait before the
+// next attempt, such as k8s.Retryable.
+type Retryable interface {
+	error
+	// RetryAfter returns the delay to wait before retrying.
+	RetryAfter() time.Duration
+}
+
+// Do has no per-attempt callback; callers report progress through fn's own
+// side effects (e.g. ui.Step), keeping this package free of any UI
+// dependency.
+
+// Do calls fn until it succeeds, returns a non-retryable error, or policy's
+// wall-clock budget is exhausted. An error is treated as retryable if it (or
+// something in its chain) implements Retryable; the delay before the next
+// attempt is the larger of that error's RetryAfter() and the current backoff
+// step, jittered by up to 20%. Any other error is returned immediately.
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	policy = policy.withDefaults()
+
+	deadline := time.Now().Add(policy.Timeout)
+	interval := policy.InitialInterval
+
+	for {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var retryable Retryable
+		if !errors.As(err, &retryable) {
+			return err
+		}
+
+		delay := interval
+		if after := retryable.RetryAfter(); after > delay {
+			delay = after
+		}
+		delay = jitter(delay)
+
+		if time.Now().Add(delay).After(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		interval = time.Duration(float64(interval) * policy.Multiplier)
+		if interval > policy.MaxInterval {
+			interval = policy.MaxInterval
+		}
+	}
+}
+
+// jitter returns d adjusted by up to ±20%, so many parallel retries don't
+// all hammer the cluster in lockstep.
+func jitter(d time.Duration) time.Duration {
+	spread := float64(d) * 0.2
+	offset := (rand.Float64()*2 - 1) * spread
+	return d + time.Duration(offset)
+}
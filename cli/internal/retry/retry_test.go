@@ -0,0 +1,88 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testRetryable is a minimal Retryable stub so these tests don't depend on
+// any real caller's error type.
+type testRetryable struct {
+	after time.Duration
+}
+
+func (e *testRetryable) Error() string             { return "transient" }
+func (e *testRetryable) RetryAfter() time.Duration { return e.after }
+
+func TestDoSucceedsFirstTry(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Policy{}, func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do() = %v, want nil", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestDoReturnsTerminalErrorImmediately(t *testing.T) {
+	calls := 0
+	terminal := errors.New("boom")
+	err := Do(context.Background(), Policy{}, func(ctx context.Context) error {
+		calls++
+		return terminal
+	})
+	if err != terminal {
+		t.Fatalf("Do() = %v, want %v", err, terminal)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1 (terminal errors must not retry)", calls)
+	}
+}
+
+func TestDoRetriesTransientErrorUntilSuccess(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Policy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Timeout: time.Second},
+		func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return &testRetryable{after: time.Millisecond}
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("Do() = %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestDoGivesUpWhenBudgetExhausted(t *testing.T) {
+	retryErr := &testRetryable{after: 10 * time.Millisecond}
+	err := Do(context.Background(), Policy{InitialInterval: 5 * time.Millisecond, MaxInterval: 5 * time.Millisecond, Timeout: 20 * time.Millisecond},
+		func(ctx context.Context) error {
+			return retryErr
+		})
+	if err != retryErr {
+		t.Fatalf("Do() = %v, want %v once the retry budget is exhausted", err, retryErr)
+	}
+}
+
+func TestDoRespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Do(ctx, Policy{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Timeout: time.Second},
+		func(ctx context.Context) error {
+			return &testRetryable{after: time.Millisecond}
+		})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() = %v, want context.Canceled", err)
+	}
+}
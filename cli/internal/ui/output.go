@@ -119,12 +119,7 @@ func Subtitle(text string) {
 
 // Section prints a section header.
 func Section(title string) {
-	style := lipgloss.NewStyle().
-		Foreground(ETHPetrol).
-		Bold(true).
-		MarginTop(1)
-
-	fmt.Fprintln(os.Stderr, style.Render(title))
+	activeSink.Section(title)
 }
 
 // Divider prints a horizontal line.
@@ -138,42 +133,22 @@ func Divider() {
 
 // Success prints a success message with a checkmark.
 func Success(msg string, keyvals ...interface{}) {
-	icon := Styles.StatusOK.Render(Symbols.Success)
-	text := Styles.Success.Render(msg)
-	printStatusLine(icon, text, keyvals...)
+	activeSink.Success(msg, keyvals...)
 }
 
 // Error prints an error message with an X mark.
 func Error(msg string, keyvals ...interface{}) {
-	icon := Styles.StatusFail.Render(Symbols.Error)
-	text := Styles.Error.Render(msg)
-	printStatusLine(icon, text, keyvals...)
+	activeSink.Error(msg, keyvals...)
 }
 
 // Warn prints a warning message with an exclamation mark.
 func Warn(msg string, keyvals ...interface{}) {
-	icon := Styles.StatusWarn.Render(Symbols.Warning)
-	text := Styles.Warning.Render(msg)
-	printStatusLine(icon, text, keyvals...)
+	activeSink.Warn(msg, keyvals...)
 }
 
 // Info prints an info message with an arrow.
 func Info(msg string, keyvals ...interface{}) {
-	icon := Styles.StatusInfo.Render(Symbols.Info)
-	printStatusLine(icon, msg, keyvals...)
-}
-
-func printStatusLine(icon, msg string, keyvals ...interface{}) {
-	fmt.Fprintf(os.Stderr, "%s %s", icon, msg)
-	if len(keyvals) > 0 {
-		fmt.Fprintf(os.Stderr, " ")
-		for i := 0; i < len(keyvals); i += 2 {
-			if i+1 < len(keyvals) {
-				fmt.Fprintf(os.Stderr, "%s=%v ", Styles.Dim.Render(fmt.Sprint(keyvals[i])), keyvals[i+1])
-			}
-		}
-	}
-	fmt.Fprintln(os.Stderr)
+	activeSink.Info(msg, keyvals...)
 }
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -182,27 +157,21 @@ func printStatusLine(icon, msg string, keyvals ...interface{}) {
 
 // KeyValue prints a key-value pair with consistent formatting.
 func KeyValue(key, value string) {
-	keyStyle := Styles.Dim.Width(14)
-	fmt.Fprintf(os.Stderr, "  %s %s\n", keyStyle.Render(key), value)
+	fmt.Fprintf(os.Stderr, "  %s %s\n", prefixes.keyStyle.Render(key), value)
 }
 
 // KeyValueStatus prints a key-value pair with a status indicator.
 func KeyValueStatus(key, value string, ok bool) {
-	keyStyle := Styles.Dim.Width(14)
-	var status string
+	status := prefixes.warning
 	if ok {
-		status = Styles.StatusOK.Render(Symbols.Success)
-	} else {
-		status = Styles.StatusWarn.Render(Symbols.Warning)
+		status = prefixes.success
 	}
-	fmt.Fprintf(os.Stderr, "  %s %s %s\n", keyStyle.Render(key), value, status)
+	fmt.Fprintf(os.Stderr, "  %s %s %s\n", prefixes.keyStyle.Render(key), value, status)
 }
 
 // KeyValueDim prints a key-value pair with dimmed value (for "not set" etc).
 func KeyValueDim(key, value string) {
-	keyStyle := Styles.Dim.Width(14)
-	valueStyle := Styles.Dim
-	fmt.Fprintf(os.Stderr, "  %s %s\n", keyStyle.Render(key), valueStyle.Render(value))
+	fmt.Fprintf(os.Stderr, "  %s %s\n", prefixes.keyStyle.Render(key), Styles.Dim.Render(value))
 }
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -211,59 +180,32 @@ func KeyValueDim(key, value string) {
 
 // Step prints a step indicator for multi-step operations.
 func Step(current, total int, description string) {
-	stepStyle := lipgloss.NewStyle().
-		Foreground(ETHBlue).
-		Bold(true)
-
-	descStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#CCCCCC"))
-
-	step := stepStyle.Render(fmt.Sprintf("[%d/%d]", current, total))
-	desc := descStyle.Render(description)
-
-	fmt.Fprintf(os.Stderr, "%s %s\n", step, desc)
+	activeSink.Step(current, total, description)
 }
 
 // StepDone prints a completed step.
 func StepDone(current, total int, description string) {
-	stepStyle := lipgloss.NewStyle().
-		Foreground(ColorSuccess).
-		Bold(true)
-
-	step := stepStyle.Render(fmt.Sprintf("[%d/%d]", current, total))
-	icon := Styles.StatusOK.Render(Symbols.Success)
-
-	fmt.Fprintf(os.Stderr, "%s %s %s\n", step, icon, description)
+	activeSink.StepDone(current, total, description)
 }
 
 // StepFail prints a failed step.
 func StepFail(current, total int, description string) {
-	stepStyle := lipgloss.NewStyle().
-		Foreground(ColorError).
-		Bold(true)
-
-	step := stepStyle.Render(fmt.Sprintf("[%d/%d]", current, total))
-	icon := Styles.StatusFail.Render(Symbols.Error)
-
-	fmt.Fprintf(os.Stderr, "%s %s %s\n", step, icon, description)
+	activeSink.StepFail(current, total, description)
 }
 
 // TaskStart prints a task starting message with arrow.
 func TaskStart(task string) {
-	icon := lipgloss.NewStyle().Foreground(ETHPetrol).Render("▸")
-	fmt.Fprintf(os.Stderr, "%s %s\n", icon, task)
+	activeSink.TaskStart(task)
 }
 
 // TaskDone prints a task completed message.
 func TaskDone(task string) {
-	icon := Styles.StatusOK.Render(Symbols.Success)
-	fmt.Fprintf(os.Stderr, "%s %s\n", icon, Styles.Success.Render(task))
+	activeSink.TaskDone(task)
 }
 
 // TaskFail prints a task failed message.
 func TaskFail(task string) {
-	icon := Styles.StatusFail.Render(Symbols.Error)
-	fmt.Fprintf(os.Stderr, "%s %s\n", icon, Styles.Error.Render(task))
+	activeSink.TaskFail(task)
 }
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -287,17 +229,14 @@ func Hint(text string) {
 
 // ListItem prints a bulleted list item.
 func ListItem(text string) {
-	bullet := Styles.Dim.Render("•")
-	fmt.Fprintf(os.Stderr, "  %s %s\n", bullet, text)
+	fmt.Fprintf(os.Stderr, "  %s %s\n", prefixes.bullet, text)
 }
 
 // ListItemStatus prints a list item with status icon.
 func ListItemStatus(text string, ok bool) {
-	var icon string
+	icon := prefixes.failure
 	if ok {
-		icon = Styles.StatusOK.Render(Symbols.Success)
-	} else {
-		icon = Styles.StatusFail.Render(Symbols.Error)
+		icon = prefixes.success
 	}
 	fmt.Fprintf(os.Stderr, "  %s %s\n", icon, text)
 }
@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Confirm prints prompt followed by "[y/N]" and reads a line from stdin,
+// returning true only for an explicit y/yes (case-insensitive). Any other
+// input, including a bare Enter or a read error (e.g. stdin isn't a
+// terminal), is treated as "no" so a non-interactive invocation never
+// accidentally proceeds with a destructive action.
+func Confirm(prompt string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", prompt)
+
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
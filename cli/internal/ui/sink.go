@@ -0,0 +1,216 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Sink is the pluggable backend for dap's event-style output - the messages
+// emitted by Info/Success/Warn/Error/Section/Step*/Task*. Everything else in
+// this package (banners, key-value tables, boxes, hints) is purely visual
+// and always renders the same way regardless of format, since it has no
+// obvious machine-readable shape.
+type Sink interface {
+	Info(msg string, keyvals ...interface{})
+	Success(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+	Section(title string)
+	Step(current, total int, description string)
+	StepDone(current, total int, description string)
+	StepFail(current, total int, description string)
+	TaskStart(task string)
+	TaskDone(task string)
+	TaskFail(task string)
+}
+
+// activeSink is the Sink every package-level Info/Success/... call is routed
+// through. It defaults to TextSink so existing behavior (and every caller
+// that predates --log-format) is unchanged until SetFormat picks something
+// else.
+var activeSink Sink = &TextSink{}
+
+// quiet suppresses TextSink's routine progress output (Info/Section/Step/
+// TaskStart) when set via SetQuiet, so scripts only see warnings, errors,
+// and final outcomes. It has no effect on JSONSink/GitHubActionsSink, which
+// are already structured rather than chatty.
+var quiet bool
+
+// SetQuiet toggles quiet mode for TextSink.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
+// Formats understood by --log-format.
+const (
+	FormatText   = "text"
+	FormatJSON   = "json"
+	FormatGitHub = "github"
+)
+
+// SetFormat installs the Sink matching format. An empty format auto-selects
+// FormatGitHub when GITHUB_ACTIONS=true (so CI gets annotated output without
+// extra flags) and FormatText otherwise. Any other value must be one of the
+// Format constants.
+func SetFormat(format string) error {
+	if format == "" {
+		format = defaultFormat()
+	}
+
+	switch format {
+	case FormatText:
+		activeSink = &TextSink{}
+	case FormatJSON:
+		activeSink = &JSONSink{}
+	case FormatGitHub:
+		activeSink = &GitHubActionsSink{}
+	default:
+		return fmt.Errorf("unknown --log-format %q (want one of: %s, %s, %s)", format, FormatText, FormatJSON, FormatGitHub)
+	}
+	return nil
+}
+
+func defaultFormat() string {
+	if os.Getenv("GITHUB_ACTIONS") == "true" {
+		return FormatGitHub
+	}
+	return FormatText
+}
+
+// keyvalsToFields pairs up the key/value varargs shared by every Sink method
+// into a map, dropping a trailing unpaired key.
+func keyvalsToFields(keyvals ...interface{}) map[string]interface{} {
+	if len(keyvals) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, len(keyvals)/2)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		fields[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	return fields
+}
+
+// JSONSink emits one JSON object per event to stdout, for CI systems that
+// want to parse dap's output rather than scrape ANSI text.
+type JSONSink struct{}
+
+type jsonEvent struct {
+	TS     string                 `json:"ts"`
+	Level  string                 `json:"level"`
+	Event  string                 `json:"event"`
+	Msg    string                 `json:"msg"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+func (s *JSONSink) emit(level, event, msg string, keyvals ...interface{}) {
+	line, err := json.Marshal(jsonEvent{
+		TS:     time.Now().UTC().Format(time.RFC3339),
+		Level:  level,
+		Event:  event,
+		Msg:    msg,
+		Fields: keyvalsToFields(keyvals...),
+	})
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(os.Stdout, string(line))
+}
+
+func (s *JSONSink) Info(msg string, keyvals ...interface{}) { s.emit("info", "info", msg, keyvals...) }
+func (s *JSONSink) Success(msg string, keyvals ...interface{}) {
+	s.emit("info", "success", msg, keyvals...)
+}
+func (s *JSONSink) Warn(msg string, keyvals ...interface{}) { s.emit("warn", "warn", msg, keyvals...) }
+func (s *JSONSink) Error(msg string, keyvals ...interface{}) {
+	s.emit("error", "error", msg, keyvals...)
+}
+func (s *JSONSink) Section(title string) { s.emit("info", "section", title) }
+
+func (s *JSONSink) Step(current, total int, description string) {
+	s.emit("info", "step", description, "current", current, "total", total)
+}
+
+func (s *JSONSink) StepDone(current, total int, description string) {
+	s.emit("info", "step_done", description, "current", current, "total", total)
+}
+
+func (s *JSONSink) StepFail(current, total int, description string) {
+	s.emit("error", "step_fail", description, "current", current, "total", total)
+}
+
+func (s *JSONSink) TaskStart(task string) { s.emit("info", "task_start", task) }
+func (s *JSONSink) TaskDone(task string)  { s.emit("info", "task_done", task) }
+func (s *JSONSink) TaskFail(task string)  { s.emit("error", "task_fail", task) }
+
+// GitHubActionsSink emits GitHub Actions workflow commands so steps render
+// as collapsible groups and messages show up as annotations on the PR.
+// Workflow commands must be written to stdout for the runner to pick them
+// up, so - unlike TextSink - this sink doesn't write to stderr at all.
+type GitHubActionsSink struct {
+	groupOpen bool
+}
+
+func (s *GitHubActionsSink) endGroup() {
+	if s.groupOpen {
+		fmt.Fprintln(os.Stdout, "::endgroup::")
+		s.groupOpen = false
+	}
+}
+
+func (s *GitHubActionsSink) startGroup(title string) {
+	s.endGroup()
+	fmt.Fprintf(os.Stdout, "::group::%s\n", title)
+	s.groupOpen = true
+}
+
+func withFields(msg string, keyvals ...interface{}) string {
+	fields := keyvalsToFields(keyvals...)
+	if len(fields) == 0 {
+		return msg
+	}
+	for k, v := range fields {
+		msg += fmt.Sprintf(" %s=%v", k, v)
+	}
+	return msg
+}
+
+func (s *GitHubActionsSink) Info(msg string, keyvals ...interface{}) {
+	fmt.Fprintln(os.Stdout, withFields(msg, keyvals...))
+}
+
+func (s *GitHubActionsSink) Success(msg string, keyvals ...interface{}) {
+	fmt.Fprintf(os.Stdout, "::notice::%s\n", withFields(msg, keyvals...))
+}
+
+func (s *GitHubActionsSink) Warn(msg string, keyvals ...interface{}) {
+	fmt.Fprintf(os.Stdout, "::warning::%s\n", withFields(msg, keyvals...))
+}
+
+func (s *GitHubActionsSink) Error(msg string, keyvals ...interface{}) {
+	fmt.Fprintf(os.Stdout, "::error::%s\n", withFields(msg, keyvals...))
+}
+
+func (s *GitHubActionsSink) Section(title string) {
+	s.startGroup(title)
+}
+
+func (s *GitHubActionsSink) Step(current, total int, description string) {
+	s.startGroup(fmt.Sprintf("[%d/%d] %s", current, total, description))
+}
+
+func (s *GitHubActionsSink) StepDone(current, total int, description string) {
+	s.endGroup()
+	fmt.Fprintf(os.Stdout, "::notice::[%d/%d] %s\n", current, total, description)
+}
+
+func (s *GitHubActionsSink) StepFail(current, total int, description string) {
+	s.endGroup()
+	fmt.Fprintf(os.Stdout, "::error::[%d/%d] %s\n", current, total, description)
+}
+
+func (s *GitHubActionsSink) TaskStart(task string) { fmt.Fprintln(os.Stdout, task) }
+func (s *GitHubActionsSink) TaskDone(task string)  { fmt.Fprintf(os.Stdout, "::notice::%s\n", task) }
+func (s *GitHubActionsSink) TaskFail(task string)  { fmt.Fprintf(os.Stdout, "::error::%s\n", task) }
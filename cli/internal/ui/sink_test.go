@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetQuietSuppressesRoutineOutput(t *testing.T) {
+	SetQuiet(true)
+	defer SetQuiet(false)
+
+	output := captureStderr(func() {
+		Info("info message")
+		Section("a section")
+		Step(1, 2, "stepping")
+		TaskStart("a task")
+	})
+
+	if output != "" {
+		t.Errorf("output with quiet=true = %q, want empty", output)
+	}
+}
+
+func TestSetQuietStillShowsFailuresAndOutcomes(t *testing.T) {
+	SetQuiet(true)
+	defer SetQuiet(false)
+
+	output := captureStderr(func() {
+		Warn("a warning")
+		Error("an error")
+		StepFail(1, 2, "step failed")
+	})
+
+	if !strings.Contains(output, "a warning") {
+		t.Error("Warn() output was suppressed by quiet mode")
+	}
+	if !strings.Contains(output, "an error") {
+		t.Error("Error() output was suppressed by quiet mode")
+	}
+	if !strings.Contains(output, "step failed") {
+		t.Error("StepFail() output was suppressed by quiet mode")
+	}
+}
+
+func TestSetFormatUnknownFormatReturnsError(t *testing.T) {
+	defer SetFormat(FormatText)
+
+	if err := SetFormat("xml"); err == nil {
+		t.Error("SetFormat(\"xml\") returned nil error, want an error for an unknown format")
+	}
+}
+
+func TestSetFormatSwitchesSink(t *testing.T) {
+	defer SetFormat(FormatText)
+
+	if err := SetFormat(FormatJSON); err != nil {
+		t.Fatalf("SetFormat(FormatJSON) returned error: %v", err)
+	}
+	if _, ok := activeSink.(*JSONSink); !ok {
+		t.Errorf("activeSink = %T, want *JSONSink", activeSink)
+	}
+}
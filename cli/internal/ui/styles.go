@@ -107,6 +107,46 @@ var Symbols = struct {
 	Dot:     "•",
 }
 
+// prefixes holds the symbol+color strings that hot-path helpers like Info and
+// KeyValue print on nearly every invocation. They're rendered once for the
+// current color profile instead of on every call - see renderPrefixes.
+var prefixes struct {
+	success   string
+	failure   string
+	warning   string
+	info      string
+	bullet    string
+	taskArrow string
+	keyStyle  lipgloss.Style
+}
+
+// stepStyles holds the styles Step/StepDone/StepFail reuse across calls
+// instead of building a fresh lipgloss.Style per step.
+var stepStyles struct {
+	num     lipgloss.Style
+	desc    lipgloss.Style
+	numDone lipgloss.Style
+	numFail lipgloss.Style
+}
+
+// renderPrefixes (re)computes every pre-rendered prefix for the current
+// color profile. It runs at init and again whenever the profile changes
+// (DisableColors), so a late --no-color flag still takes effect.
+func renderPrefixes() {
+	prefixes.success = Styles.StatusOK.Render(Symbols.Success)
+	prefixes.failure = Styles.StatusFail.Render(Symbols.Error)
+	prefixes.warning = Styles.StatusWarn.Render(Symbols.Warning)
+	prefixes.info = Styles.StatusInfo.Render(Symbols.Info)
+	prefixes.bullet = Styles.Dim.Render(Symbols.Dot)
+	prefixes.taskArrow = lipgloss.NewStyle().Foreground(ETHPetrol).Render("▸")
+	prefixes.keyStyle = Styles.Dim.Width(14)
+
+	stepStyles.num = lipgloss.NewStyle().Foreground(ETHBlue).Bold(true)
+	stepStyles.desc = lipgloss.NewStyle().Foreground(lipgloss.Color("#CCCCCC"))
+	stepStyles.numDone = lipgloss.NewStyle().Foreground(ColorSuccess).Bold(true)
+	stepStyles.numFail = lipgloss.NewStyle().Foreground(ColorError).Bold(true)
+}
+
 // IsCI returns true if running in a CI environment.
 func IsCI() bool {
 	return os.Getenv("CI") != "" || os.Getenv("GITHUB_ACTIONS") != ""
@@ -121,6 +161,7 @@ func IsTTY() bool {
 // DisableColors disables all color output (for CI or --no-color flag).
 func DisableColors() {
 	lipgloss.SetColorProfile(termenv.Ascii)
+	renderPrefixes()
 }
 
 // NoColor returns true if NO_COLOR env var is set (any value).
@@ -134,5 +175,7 @@ func init() {
 	// Auto-disable colors per no-color.org standard and for CI/non-TTY
 	if NoColor() || IsCI() || !IsTTY() {
 		DisableColors()
+		return
 	}
+	renderPrefixes()
 }
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TextSink is dap's original, ANSI-styled stderr output - the default Sink
+// until --log-format picks something else.
+type TextSink struct{}
+
+func (s *TextSink) Section(title string) {
+	if quiet {
+		return
+	}
+
+	style := lipgloss.NewStyle().
+		Foreground(ETHPetrol).
+		Bold(true).
+		MarginTop(1)
+
+	fmt.Fprintln(os.Stderr, style.Render(title))
+}
+
+func (s *TextSink) Success(msg string, keyvals ...interface{}) {
+	printStatusLine(prefixes.success, Styles.Success.Render(msg), keyvals...)
+}
+
+func (s *TextSink) Error(msg string, keyvals ...interface{}) {
+	printStatusLine(prefixes.failure, Styles.Error.Render(msg), keyvals...)
+}
+
+func (s *TextSink) Warn(msg string, keyvals ...interface{}) {
+	printStatusLine(prefixes.warning, Styles.Warning.Render(msg), keyvals...)
+}
+
+func (s *TextSink) Info(msg string, keyvals ...interface{}) {
+	if quiet {
+		return
+	}
+	printStatusLine(prefixes.info, msg, keyvals...)
+}
+
+func printStatusLine(icon, msg string, keyvals ...interface{}) {
+	fmt.Fprintf(os.Stderr, "%s %s", icon, msg)
+	if len(keyvals) > 0 {
+		fmt.Fprintf(os.Stderr, " ")
+		for i := 0; i < len(keyvals); i += 2 {
+			if i+1 < len(keyvals) {
+				fmt.Fprintf(os.Stderr, "%s=%v ", Styles.Dim.Render(fmt.Sprint(keyvals[i])), keyvals[i+1])
+			}
+		}
+	}
+	fmt.Fprintln(os.Stderr)
+}
+
+func (s *TextSink) Step(current, total int, description string) {
+	if quiet {
+		return
+	}
+
+	step := stepStyles.num.Render(fmt.Sprintf("[%d/%d]", current, total))
+	desc := stepStyles.desc.Render(description)
+
+	fmt.Fprintf(os.Stderr, "%s %s\n", step, desc)
+}
+
+func (s *TextSink) StepDone(current, total int, description string) {
+	step := stepStyles.numDone.Render(fmt.Sprintf("[%d/%d]", current, total))
+
+	fmt.Fprintf(os.Stderr, "%s %s %s\n", step, prefixes.success, description)
+}
+
+func (s *TextSink) StepFail(current, total int, description string) {
+	step := stepStyles.numFail.Render(fmt.Sprintf("[%d/%d]", current, total))
+
+	fmt.Fprintf(os.Stderr, "%s %s %s\n", step, prefixes.failure, description)
+}
+
+func (s *TextSink) TaskStart(task string) {
+	if quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s %s\n", prefixes.taskArrow, task)
+}
+
+func (s *TextSink) TaskDone(task string) {
+	fmt.Fprintf(os.Stderr, "%s %s\n", prefixes.success, Styles.Success.Render(task))
+}
+
+func (s *TextSink) TaskFail(task string) {
+	fmt.Fprintf(os.Stderr, "%s %s\n", prefixes.failure, Styles.Error.Render(task))
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"os"
+	"testing"
+)
+
+// silenceStderr redirects os.Stderr to /dev/null for the duration of the
+// benchmark, so it measures rendering cost rather than terminal I/O.
+func silenceStderr(b *testing.B) {
+	b.Helper()
+
+	orig := os.Stderr
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	os.Stderr = devNull
+	b.Cleanup(func() {
+		os.Stderr = orig
+		devNull.Close()
+	})
+}
+
+// BenchmarkInfo exercises the ui.Info hot path hit by the progress messages
+// of most commands.
+func BenchmarkInfo(b *testing.B) {
+	silenceStderr(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Info("Connecting to cluster...")
+	}
+}
+
+// BenchmarkKeyValue exercises the ui.KeyValue hot path used repeatedly by
+// `dap env versions` to print one line per tool.
+func BenchmarkKeyValue(b *testing.B) {
+	silenceStderr(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KeyValue("python", "3.12.1")
+	}
+}
+
+// BenchmarkStep exercises the Step/StepDone banner path used by dev.CheckCmd.
+func BenchmarkStep(b *testing.B) {
+	silenceStderr(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Step(1, 3, "Checking code style...")
+		StepDone(1, 3, "Lint passed")
+	}
+}
@@ -0,0 +1,158 @@
+// Package watch provides a debounced, recursive filesystem watcher used by
+// dev's --watch flag to re-run a check whenever a relevant file changes.
+package watch
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is used when Options.Debounce is zero. It's exported so
+// callers can use the same value as their flag default instead of
+// redeclaring it.
+const DefaultDebounce = 250 * time.Millisecond
+
+// ignoredDirs are never descended into or watched: build/test caches and VCS
+// metadata churn constantly and never contain anything a re-run cares about.
+var ignoredDirs = map[string]bool{
+	"__pycache__":   true,
+	".pytest_cache": true,
+	".git":          true,
+	".hg":           true,
+	".svn":          true,
+}
+
+// Options configures Run's debounce behavior.
+type Options struct {
+	// Debounce is how long Run waits after the last event in a burst before
+	// calling onChange, so a save that touches several files (or an
+	// editor's write-then-rename) triggers one re-run instead of several.
+	// Zero means DefaultDebounce.
+	Debounce time.Duration
+}
+
+// Run watches roots (recursively, auto-adding new subdirectories as they
+// appear) and files (individual paths outside any root, e.g. pyproject.toml)
+// for changes, calling onChange with whichever path triggered it once per
+// debounced burst of events. __pycache__, .pytest_cache, *.pyc, and common
+// VCS directories are never watched. Run blocks until ctx is cancelled, at
+// which point it returns nil; a root or file that doesn't exist yet is
+// silently skipped rather than treated as an error, since e.g. a config
+// file in the watch list is often optional.
+func Run(ctx context.Context, roots, files []string, opts Options, onChange func(path string)) error {
+	debounce := opts.Debounce
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	for _, root := range roots {
+		if err := addRecursive(w, root); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, f := range files {
+		if err := w.Add(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	var timer *time.Timer
+	var timerC <-chan time.Time
+	var pending string
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if ignoredPath(ev.Name) {
+				continue
+			}
+			if ev.Has(fsnotify.Create) {
+				if info, statErr := os.Stat(ev.Name); statErr == nil && info.IsDir() {
+					_ = addRecursive(w, ev.Name)
+				}
+			}
+
+			pending = ev.Name
+			if timer == nil {
+				timer = time.NewTimer(debounce)
+			} else if !timer.Stop() {
+				// Timer already fired and its channel was already drained
+				// by the case below (which nils out timerC), or fired and
+				// hasn't been observed yet - either way, draining
+				// non-blockingly here is safe and avoids a deadlock.
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(debounce)
+			timerC = timer.C
+
+		case <-timerC:
+			onChange(pending)
+			timerC = nil
+
+		case _, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
+
+// addRecursive adds root, and every directory beneath it that isn't in
+// ignoredDirs, to w.
+func addRecursive(w *fsnotify.Watcher, root string) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return w.Add(root)
+	}
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && ignoredDirs[d.Name()] {
+			return filepath.SkipDir
+		}
+		return w.Add(path)
+	})
+}
+
+// ignoredPath reports whether path should never trigger a re-run: a
+// compiled bytecode file, or anything under an ignored directory.
+func ignoredPath(path string) bool {
+	if strings.HasSuffix(path, ".pyc") {
+		return true
+	}
+	for _, part := range strings.Split(filepath.ToSlash(path), "/") {
+		if ignoredDirs[part] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRunDebouncesBurstIntoOneChange(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "a.py")
+	if err := os.WriteFile(file, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	changes := make(chan string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- Run(ctx, []string{dir}, nil, Options{Debounce: 50 * time.Millisecond}, func(path string) {
+			changes <- path
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond) // let the watcher start and add dir
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(file, []byte("y"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case <-changes:
+	case <-time.After(time.Second):
+		t.Fatal("onChange was never called")
+	}
+
+	select {
+	case <-changes:
+		t.Fatal("three quick writes should debounce into one onChange call")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Run() = %v, want nil after cancel", err)
+	}
+}
+
+func TestRunIgnoresPycache(t *testing.T) {
+	dir := t.TempDir()
+	cacheDir := filepath.Join(dir, "__pycache__")
+	if err := os.Mkdir(cacheDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	changes := make(chan string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- Run(ctx, []string{dir}, nil, Options{Debounce: 20 * time.Millisecond}, func(path string) {
+			changes <- path
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if err := os.WriteFile(filepath.Join(cacheDir, "a.pyc"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case p := <-changes:
+		t.Fatalf("onChange(%q) called for an ignored path", p)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	cancel()
+	<-done
+}
+
+func TestRunWatchesNewSubdirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	changes := make(chan string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- Run(ctx, []string{dir}, nil, Options{Debounce: 20 * time.Millisecond}, func(path string) {
+			changes <- path
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond) // let Run's Create handler add sub
+
+	select {
+	case <-changes:
+	case <-time.After(time.Second):
+		t.Fatal("creating the subdirectory itself should trigger onChange")
+	}
+
+	if err := os.WriteFile(filepath.Join(sub, "b.py"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-changes:
+	case <-time.After(time.Second):
+		t.Fatal("onChange was never called for a file created in the new subdirectory")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestRunReturnsNilOnMissingRoot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := Run(ctx, []string{filepath.Join(t.TempDir(), "does-not-exist")}, nil, Options{}, func(string) {})
+	if err != nil {
+		t.Errorf("Run() = %v, want nil for a root that doesn't exist yet", err)
+	}
+}
@@ -2,14 +2,10 @@
 // dap is a developer experience tool for the Data Archive Pipeline.
 package main
 
-import (
-	"os"
+//go:generate go run . meta manifest -o cli-manifest.json
 
-	"github.com/eth-library/dap/cli/cmd"
-)
+import "github.com/eth-library/dap/cli/cmd"
 
 func main() {
-	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
-	}
+	cmd.Execute()
 }